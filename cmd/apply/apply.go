@@ -0,0 +1,132 @@
+package apply
+
+import (
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/manifest"
+
+	"github.com/spf13/cobra"
+)
+
+const manifestHelp = `Manifests are YAML documents, one object each:
+
+  kind: Zone
+  name: dc1-compute
+  spec:
+    regionId: ref:Region/Datacenter:datacenter-3
+    placementPolicy: DEFAULT
+  ---
+  kind: Project
+  name: web
+  spec:
+    zoneAssignmentConfigurations:
+      - zoneId: ref:Zone/dc1-compute
+        priority: 1
+
+spec holds the fields of the IaaS specification for the kind. A string
+ref:<Kind>/<name> is replaced by the id of the object with that name, objects
+are applied after the objects they refer to. Only declared fields are managed.
+
+Kinds: `
+
+// NewApplyCommand returns iv apply
+func NewApplyCommand() *cobra.Command {
+	var files []string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "apply -f FILE|DIR",
+		Short: "create or update IaaS objects from manifests",
+		Long:  "Create or update IaaS objects from manifests.\n\n" + longHelp(),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plan, c, err := diff(cmd, files)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				plan.Print(cmd.OutOrStdout())
+				return nil
+			}
+			return plan.Apply(cmd.Context(), c, cmd.OutOrStdout())
+		},
+	}
+	addFileFlag(cmd, &files)
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the diff without changing anything")
+	return cmd
+}
+
+// NewDiffCommand returns iv diff
+func NewDiffCommand() *cobra.Command {
+	var files []string
+	var output string
+	cmd := &cobra.Command{
+		Use:   "diff -f FILE|DIR",
+		Short: "show what apply would change",
+		Long:  "Show, field by field, what apply would change.\n\n" + longHelp(),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plan, _, err := diff(cmd, files)
+			if err != nil {
+				return err
+			}
+			if output == cmdutil.OutputJSON {
+				return cmdutil.PrintJSON(cmd.OutOrStdout(), plan)
+			}
+			plan.Print(cmd.OutOrStdout())
+			return nil
+		},
+	}
+	addFileFlag(cmd, &files)
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "diff format: text|json")
+	return cmd
+}
+
+// NewDeleteCommand returns iv delete
+func NewDeleteCommand() *cobra.Command {
+	var files []string
+	cmd := &cobra.Command{
+		Use:   "delete -f FILE|DIR",
+		Short: "delete the IaaS objects declared in manifests",
+		Long:  "Delete the IaaS objects declared in manifests, dependents first.\n\n" + longHelp(),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			objs, err := manifest.Load(files...)
+			if err != nil {
+				return err
+			}
+			r, c, err := cmdutil.NewResolver(cmd)
+			if err != nil {
+				return err
+			}
+			return manifest.Delete(cmd.Context(), r, c, objs, cmd.OutOrStdout())
+		},
+	}
+	addFileFlag(cmd, &files)
+	return cmd
+}
+
+func longHelp() string {
+	return manifestHelp + strings.Join(manifest.KindNames(), ", ")
+}
+
+func addFileFlag(cmd *cobra.Command, files *[]string) {
+	cmd.Flags().StringArrayVarP(files, "filename", "f", nil, "manifest file or directory, repeatable")
+	cmd.MarkFlagRequired("filename")
+	cmd.MarkFlagFilename("filename", "yaml", "yml")
+}
+
+// diff loads the manifests and compares them with the live objects
+func diff(cmd *cobra.Command, files []string) (*manifest.Plan, *iaas.Client, error) {
+	objs, err := manifest.Load(files...)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, c, err := cmdutil.NewResolver(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan, err := manifest.Diff(cmd.Context(), r, objs)
+	return plan, c, err
+}
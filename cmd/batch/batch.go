@@ -0,0 +1,106 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/batch"
+
+	"github.com/spf13/cobra"
+)
+
+func NewBatchCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch",
+		Short: "run many REST operations described in a JSONL file",
+	}
+	cmd.AddCommand(newRunCommand())
+	return cmd
+}
+
+type runOptions struct {
+	concurrency     int
+	continueOnError bool
+	output          string
+	specs           []string
+}
+
+func newRunCommand() *cobra.Command {
+	o := &runOptions{}
+	cmd := &cobra.Command{
+		Use:   "run <requests.jsonl>",
+		Short: "execute each line of the file, writing one JSON result per line",
+		Long: `Each line is a JSON object:
+
+  {"id":"p","method":"POST","path":"/iaas/api/projects","body":{"name":"demo"}}
+  {"operationId":"getProject","params":{"id":"${p.response.id}"}}
+
+params fill {placeholders} in the path, the rest go to the query string.
+${<id>.response.<field>} and ${previous.response.<field>} pull values from
+earlier responses and imply a dependency, dependsOn adds explicit ones.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return o.run(cmd, args[0])
+		},
+	}
+	cmd.Flags().IntVarP(&o.concurrency, "concurrency", "c", 4, "maximum requests in flight")
+	cmd.Flags().BoolVar(&o.continueOnError, "continue-on-error", false, "keep running independent lines after a failure")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "results file (JSONL), defaults to stdout")
+	cmd.Flags().StringArrayVar(&o.specs, "spec", nil, "OpenAPI spec used to resolve operationId (repeatable)")
+	return cmd
+}
+
+func (o *runOptions) run(cmd *cobra.Command, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	steps, err := batch.Parse(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	ops, err := batch.LoadOperations(o.specs...)
+	if err != nil {
+		return err
+	}
+	client, err := cmdutil.NewClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = cmd.OutOrStdout()
+	if o.output != "" {
+		rf, err := os.Create(o.output)
+		if err != nil {
+			return err
+		}
+		defer rf.Close()
+		out = rf
+	}
+	enc := json.NewEncoder(out)
+	var writeErr error
+	r := &batch.Runner{
+		Client:          client,
+		Operations:      ops,
+		Concurrency:     o.concurrency,
+		ContinueOnError: o.continueOnError,
+		OnResult: func(res batch.Result) {
+			if err := enc.Encode(res); err != nil && writeErr == nil {
+				writeErr = err
+			}
+		},
+	}
+	sum := r.Run(cmd.Context(), steps)
+	fmt.Fprintln(cmd.ErrOrStderr(), sum)
+	if writeErr != nil {
+		return writeErr
+	}
+	if sum.Failed > 0 || sum.Skipped > 0 {
+		return fmt.Errorf("batch incomplete: %d failed, %d skipped", sum.Failed, sum.Skipped)
+	}
+	return nil
+}
@@ -0,0 +1,513 @@
+package cloudaccounts
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCloudAccountsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cloud-accounts",
+		Aliases: []string{"cloud-account", "ca"},
+		Short:   "onboard, list, check and delete cloud accounts",
+	}
+	cmd.AddCommand(newAddCommand(), newListCommand(), newGetCommand(), newDeleteCommand(), newHealthCommand())
+	return cmd
+}
+
+var accountHeader = []string{"id", "name", "type", "regions", "healthy"}
+
+func accountRows(accounts []iaas.CloudAccount) [][]string {
+	var rows [][]string
+	for _, a := range accounts {
+		var regions []string
+		for _, r := range a.EnabledRegions {
+			regions = append(regions, r.ExternalRegionID)
+		}
+		healthy := "unknown"
+		if a.Healthy != nil {
+			healthy = strconv.FormatBool(*a.Healthy)
+		}
+		rows = append(rows, []string{a.ID, a.Name, a.CloudAccountType, strings.Join(regions, ","), healthy})
+	}
+	return rows
+}
+
+func newListCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "list [--filter ...]",
+		Short: "list cloud accounts of every type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			accounts, err := c.ListCloudAccounts(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, accounts, accountHeader, func() [][]string { return accountRows(accounts) })
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"cloudAccountType eq 'vsphere'\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <account>",
+		Short:             "show a cloud account by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteCloudAccounts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			a, err := c.FindCloudAccount(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, a, accountHeader, func() [][]string { return accountRows([]iaas.CloudAccount{*a}) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "delete <account>",
+		Short:             "delete a cloud account by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteCloudAccounts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			a, err := c.FindCloudAccount(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.DeleteCloudAccount(cmd.Context(), a.ID)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newHealthCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "health [<account>...]",
+		Short: "run a health check on cloud accounts, all of them by default",
+		Long: `Run a health check on the given cloud accounts, or on every account, and
+show the result. The command fails when an account is unhealthy.`,
+		ValidArgsFunction: cmdutil.CompleteCloudAccounts,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			var accounts []iaas.CloudAccount
+			if len(args) == 0 {
+				if accounts, err = c.ListCloudAccounts(ctx, nil); err != nil {
+					return err
+				}
+			}
+			for _, ref := range args {
+				a, err := c.FindCloudAccount(ctx, ref)
+				if err != nil {
+					return err
+				}
+				accounts = append(accounts, *a)
+			}
+			var errs []error
+			for i, a := range accounts {
+				checked, err := c.CheckHealth(ctx, a.ID)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", a.Name, err))
+					continue
+				}
+				accounts[i] = *checked
+				if checked.Healthy != nil && !*checked.Healthy {
+					errs = append(errs, fmt.Errorf("%s is unhealthy", a.Name))
+				}
+			}
+			if err := cmdutil.Print(cmd.OutOrStdout(), output, accounts, accountHeader, func() [][]string { return accountRows(accounts) }); err != nil {
+				return err
+			}
+			return errors.Join(errs...)
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// field is a credential or connection setting of an account type, key is its
+// name in both the region enumeration and the create specification
+type field struct {
+	key, flag, usage string
+	secret, optional bool
+}
+
+// regionMode is how an account type gets its regions
+type regionMode int
+
+const (
+	// regionsEnumerated come from the region enumeration of the type
+	regionsEnumerated regionMode = iota
+	// regionsGiven have no enumeration, --region names them
+	regionsGiven
+	// regionsNone are accounts without regions (NSX-T, NSX-V)
+	regionsNone
+)
+
+type accountType struct {
+	name, short string
+	fields      []field
+	regions     regionMode
+	// selfSigned types accept acceptSelfSignedCertificate
+	selfSigned bool
+	// keyFile is the GCP service account key file, it fills the credentials
+	keyFile bool
+}
+
+var (
+	fHost     = field{key: "hostName", flag: "host", usage: "host name of the endpoint"}
+	fUsername = field{key: "username", flag: "username", usage: "user name"}
+	fPassword = field{key: "password", flag: "password", usage: "password", secret: true}
+	fDCID     = field{key: "dcid", flag: "dcid", usage: "id of the data collector for on premise endpoints", optional: true}
+)
+
+var accountTypes = []accountType{
+	{name: "vsphere", short: "vSphere", fields: []field{fHost, fUsername, fPassword, fDCID}, selfSigned: true},
+	{name: "aws", short: "Amazon Web Services", fields: []field{
+		{key: "accessKeyId", flag: "access-key-id", usage: "access key id"},
+		{key: "secretAccessKey", flag: "secret-access-key", usage: "secret access key", secret: true},
+	}},
+	{name: "azure", short: "Microsoft Azure", fields: []field{
+		{key: "subscriptionId", flag: "subscription-id", usage: "subscription id"},
+		{key: "tenantId", flag: "tenant-id", usage: "tenant id"},
+		{key: "clientApplicationId", flag: "client-id", usage: "client application id"},
+		{key: "clientApplicationSecretKey", flag: "client-secret", usage: "client application secret key", secret: true},
+	}},
+	{name: "gcp", short: "Google Cloud Platform", keyFile: true, fields: []field{
+		{key: "projectId", flag: "project-id", usage: "project id"},
+		{key: "privateKeyId", flag: "private-key-id", usage: "private key id"},
+		{key: "privateKey", flag: "private-key", usage: "private key, PEM", secret: true},
+		{key: "clientEmail", flag: "client-email", usage: "client email"},
+	}},
+	{name: "vmc", short: "VMware Cloud on AWS", selfSigned: true, fields: []field{
+		{key: "apiKey", flag: "api-key", usage: "VMC API token", secret: true},
+		{key: "sddcId", flag: "sddc-id", usage: "SDDC id"},
+		{key: "hostName", flag: "host", usage: "vCenter host name in the SDDC"},
+		{key: "nsxHostName", flag: "nsx-host", usage: "NSX manager host name in the SDDC"},
+		{key: "username", flag: "username", usage: "vCenter user name"},
+		{key: "password", flag: "password", usage: "vCenter password", secret: true},
+		{key: "dcId", flag: "dcid", usage: "id of the data collector", optional: true},
+	}},
+	{name: "vcf", short: "VMware Cloud Foundation", selfSigned: true, fields: []field{
+		{key: "workloadDomainId", flag: "workload-domain-id", usage: "workload domain id"},
+		{key: "workloadDomainName", flag: "workload-domain-name", usage: "workload domain name"},
+		{key: "sddcManagerId", flag: "sddc-manager-id", usage: "SDDC manager integration id", optional: true},
+		{key: "vcenterHostName", flag: "vcenter-host", usage: "vCenter host name"},
+		{key: "vcenterUsername", flag: "vcenter-username", usage: "vCenter user name"},
+		{key: "vcenterPassword", flag: "vcenter-password", usage: "vCenter password", secret: true},
+		{key: "nsxHostName", flag: "nsx-host", usage: "NSX manager host name"},
+		{key: "nsxUsername", flag: "nsx-username", usage: "NSX user name"},
+		{key: "nsxPassword", flag: "nsx-password", usage: "NSX password", secret: true},
+		{key: "dcId", flag: "dcid", usage: "id of the data collector", optional: true},
+	}},
+	{name: "nsx-t", short: "NSX-T", regions: regionsNone, selfSigned: true,
+		fields: []field{fHost, fUsername, fPassword, {key: "dcid", flag: "dcid", usage: "id of the data collector"}}},
+	{name: "nsx-v", short: "NSX-V", regions: regionsNone, selfSigned: true,
+		fields: []field{fHost, fUsername, fPassword, {key: "dcid", flag: "dcid", usage: "id of the data collector"}}},
+	{name: "nsx-alb", short: "NSX Advanced Load Balancer", regions: regionsGiven, selfSigned: true,
+		fields: []field{fHost, fUsername, fPassword}},
+}
+
+func newAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <type>",
+		Short: "onboard a cloud account: " + strings.Join(iaas.CloudAccountTypes, ", "),
+		Long: `Onboard a cloud account. Credentials come from flags, whatever is missing is
+asked for when stdin is a terminal. The regions the credentials can see are
+enumerated and offered for selection unless --region or --all-regions picks
+them, the account is created with the chosen regions and health checked.`,
+	}
+	for _, t := range accountTypes {
+		cmd.AddCommand(newAddTypeCommand(t))
+	}
+	return cmd
+}
+
+func newAddTypeCommand(t accountType) *cobra.Command {
+	values := make([]string, len(t.fields))
+	var name, description, keyFile, output string
+	var regions []string
+	var allRegions, selfSigned, defaultZones bool
+	cmd := &cobra.Command{
+		Use:   t.name + " --name <name>",
+		Short: "onboard a " + t.short + " account",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			p := cmdutil.NewPrompter(cmd)
+			if t.keyFile && keyFile != "" {
+				if err := readKeyFile(keyFile, t.fields, values); err != nil {
+					return err
+				}
+			}
+			if name == "" {
+				if !p.Interactive() {
+					return errors.New("--name is required")
+				}
+				var err error
+				if name, err = p.Ask("Account name", ""); err != nil {
+					return err
+				}
+			}
+			creds := map[string]any{}
+			for i, f := range t.fields {
+				v, err := value(p, f, values[i])
+				if err != nil {
+					return err
+				}
+				if v != "" {
+					creds[f.key] = v
+				}
+			}
+			if selfSigned {
+				creds["acceptSelfSignedCertificate"] = true
+			}
+
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			spec := map[string]any{"name": name}
+			if description != "" {
+				spec["description"] = description
+			}
+			for k, v := range creds {
+				spec[k] = v
+			}
+			switch t.regions {
+			case regionsEnumerated:
+				fmt.Fprintln(cmd.ErrOrStderr(), "enumerating regions...")
+				available, err := c.EnumerateRegions(ctx, t.name, creds)
+				if err != nil {
+					return fmt.Errorf("region enumeration: %w", err)
+				}
+				chosen, err := chooseRegions(cmd.ErrOrStderr(), p, available, regions, allRegions)
+				if err != nil {
+					return err
+				}
+				spec["regions"], spec["createDefaultZones"] = chosen, defaultZones
+			case regionsGiven:
+				if len(regions) == 0 {
+					return errors.New("--region is required, " + t.name + " regions cannot be enumerated")
+				}
+				var chosen []iaas.RegionSpecification
+				for _, r := range regions {
+					chosen = append(chosen, iaas.RegionSpecification{ExternalRegionID: r, Name: r})
+				}
+				spec["regions"] = chosen
+			}
+
+			tr, err := c.CreateCloudAccount(ctx, t.name, spec)
+			if err == nil {
+				tr, err = c.Wait(ctx, tr)
+			}
+			if err != nil {
+				return err
+			}
+			ids := tr.ResourceIDs()
+			if len(ids) == 0 {
+				return fmt.Errorf("request %s finished without the new account", tr.ID)
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "created %s (%s), checking health...\n", name, ids[0])
+			a, err := c.CheckHealth(ctx, ids[0])
+			if err != nil {
+				return fmt.Errorf("account %s was created but the health check failed: %w", ids[0], err)
+			}
+			if err := cmdutil.Print(cmd.OutOrStdout(), output, a, accountHeader, func() [][]string { return accountRows([]iaas.CloudAccount{*a}) }); err != nil {
+				return err
+			}
+			if a.Healthy != nil && !*a.Healthy {
+				return fmt.Errorf("account %s was created but is unhealthy", a.Name)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&name, "name", "", "name of the account")
+	cmd.Flags().StringVar(&description, "description", "", "description of the account")
+	for i, f := range t.fields {
+		usage := f.usage
+		if f.secret {
+			usage += ", asked for without echo when not set"
+		}
+		cmd.Flags().StringVar(&values[i], f.flag, "", usage)
+	}
+	if t.keyFile {
+		cmd.Flags().StringVar(&keyFile, "key-file", "", "service account key file (JSON), fills the credentials not set by flags")
+	}
+	if t.selfSigned {
+		cmd.Flags().BoolVar(&selfSigned, "accept-self-signed", false, "accept a self signed certificate")
+	}
+	switch t.regions {
+	case regionsEnumerated:
+		cmd.Flags().StringSliceVar(&regions, "region", nil, "regions to enable by external id or name, repeatable")
+		cmd.Flags().BoolVar(&allRegions, "all-regions", false, "enable every enumerated region")
+		cmd.Flags().BoolVar(&defaultZones, "create-default-zones", false, "create a cloud zone per enabled region")
+	case regionsGiven:
+		cmd.Flags().StringSliceVar(&regions, "region", nil, "external ids of the regions to enable, repeatable")
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// value returns the flag value of a field, or asks for it
+func value(p *cmdutil.Prompter, f field, v string) (string, error) {
+	if v != "" || f.optional {
+		return v, nil
+	}
+	if !p.Interactive() {
+		return "", fmt.Errorf("--%s is required", f.flag)
+	}
+	label := strings.ToUpper(f.usage[:1]) + f.usage[1:]
+	if f.secret {
+		v, err := p.Secret(label)
+		if errors.Is(err, cmdutil.ErrEcho) {
+			return "", fmt.Errorf("%w, pass --%s instead", err, f.flag)
+		}
+		return v, err
+	}
+	return p.Ask(label, "")
+}
+
+// readKeyFile fills the GCP credentials from a service account key file
+func readKeyFile(path string, fields []field, values []string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var key map[string]string
+	if err := json.Unmarshal(data, &key); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	names := map[string]string{"projectId": "project_id", "privateKeyId": "private_key_id", "privateKey": "private_key", "clientEmail": "client_email"}
+	for i, f := range fields {
+		if values[i] == "" {
+			values[i] = key[names[f.key]]
+		}
+	}
+	return nil
+}
+
+// chooseRegions picks regions by --region, --all-regions or by asking
+func chooseRegions(w io.Writer, p *cmdutil.Prompter, available []iaas.RegionSpecification, requested []string, all bool) ([]iaas.RegionSpecification, error) {
+	if len(available) == 0 {
+		return nil, errors.New("the credentials see no regions")
+	}
+	if all {
+		return available, nil
+	}
+	if len(requested) > 0 {
+		return matchRegions(available, requested)
+	}
+	var names []string
+	for _, r := range available {
+		names = append(names, r.ExternalRegionID)
+	}
+	if !p.Interactive() {
+		return nil, fmt.Errorf("set --region or --all-regions, available: %s", strings.Join(names, ", "))
+	}
+	for i, r := range available {
+		fmt.Fprintf(w, "%3d  %s (%s)\n", i+1, r.ExternalRegionID, r.Name)
+	}
+	for {
+		answer, err := p.Ask("Regions (numbers or ranges like 1,3-5, or all)", "all")
+		if err != nil {
+			return nil, err
+		}
+		chosen, err := pickRegions(available, answer)
+		if err == nil {
+			return chosen, nil
+		}
+		fmt.Fprintln(w, err)
+	}
+}
+
+func matchRegions(available []iaas.RegionSpecification, requested []string) ([]iaas.RegionSpecification, error) {
+	var chosen []iaas.RegionSpecification
+	for _, want := range requested {
+		found := false
+		for _, r := range available {
+			if r.ExternalRegionID == want || r.Name == want {
+				chosen, found = append(chosen, r), true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("region %s is not available to these credentials", want)
+		}
+	}
+	return chosen, nil
+}
+
+// pickRegions parses an answer like 1,3-5 or all
+func pickRegions(available []iaas.RegionSpecification, answer string) ([]iaas.RegionSpecification, error) {
+	if strings.TrimSpace(answer) == "all" {
+		return available, nil
+	}
+	var chosen []iaas.RegionSpecification
+	picked := map[int]bool{}
+	for _, part := range strings.Split(answer, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		from, to, isRange := strings.Cut(part, "-")
+		lo, err := strconv.Atoi(strings.TrimSpace(from))
+		hi := lo
+		if err == nil && isRange {
+			hi, err = strconv.Atoi(strings.TrimSpace(to))
+		}
+		if err != nil || lo < 1 || hi > len(available) || lo > hi {
+			return nil, fmt.Errorf("invalid selection %q, use numbers between 1 and %d", part, len(available))
+		}
+		for i := lo; i <= hi; i++ {
+			if !picked[i] {
+				picked[i] = true
+				chosen = append(chosen, available[i-1])
+			}
+		}
+	}
+	if len(chosen) == 0 {
+		return nil, errors.New("no region selected")
+	}
+	return chosen, nil
+}
@@ -0,0 +1,113 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"iv/pkg/config"
+	"iv/pkg/connect"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/endpoints/vra/iam"
+	"iv/pkg/endpoints/vra/projects"
+	"iv/pkg/manifest"
+	"iv/pkg/rest"
+
+	"github.com/spf13/cobra"
+)
+
+// package cmdutil has the helpers shared by the iv subcommands
+
+// ProfileFlag is the persistent flag on the root command selecting the profile
+const ProfileFlag = "profile"
+
+// Profile resolves the vRA profile selected for this invocation
+func Profile(cmd *cobra.Command) (*config.Profile, error) {
+	name, _ := cmd.Flags().GetString(ProfileFlag)
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	return cfg.Active(name)
+}
+
+// NewClient returns an authenticated rest client for the active profile
+// refresh tokens are exchanged for an access token on every invocation
+func NewClient(cmd *cobra.Command) (*rest.Client, error) {
+	p, err := Profile(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return ClientForProfile(cmd, p)
+}
+
+// ClientForProfile is NewClient for an already resolved profile
+func ClientForProfile(cmd *cobra.Command, p *config.Profile) (*rest.Client, error) {
+	return connect.Client(cmd.Context(), p, false, func(msg string) { fmt.Fprintln(cmd.ErrOrStderr(), "warning:", msg) })
+}
+
+// NewIaaSClient returns the IaaS client for the active profile
+func NewIaaSClient(cmd *cobra.Command) (*iaas.Client, error) {
+	c, err := NewClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return iaas.New(c), nil
+}
+
+// NewProjectsClient returns the Project Service client for the active profile
+func NewProjectsClient(cmd *cobra.Command) (*projects.Client, error) {
+	c, err := NewClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return projects.New(c), nil
+}
+
+// NewResolver returns the manifest resolver for the active profile, projects
+// are read through both the IaaS API and the Project Service
+func NewResolver(cmd *cobra.Command) (*manifest.Resolver, *iaas.Client, error) {
+	c, err := NewClient(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+	ic := iaas.New(c)
+	return manifest.NewResolver(ic).WithProjectService(projects.New(c)), ic, nil
+}
+
+// NewIAMClient returns the identity and access client for the active profile
+func NewIAMClient(cmd *cobra.Command) (*iam.Client, error) {
+	c, err := NewClient(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return iam.New(c)
+}
+
+// OrgFlag selects the org for the org and user commands
+const OrgFlag = "org"
+
+// AddOrgFlag registers --org, which defaults to the user's default org
+func AddOrgFlag(cmd *cobra.Command) {
+	cmd.Flags().String(OrgFlag, "", "org id, defaults to the logged in user's default org")
+	cmd.RegisterFlagCompletionFunc(OrgFlag, CompleteOrgs)
+}
+
+// OrgID returns --org or looks up the default org
+func OrgID(cmd *cobra.Command, c *iam.Client) (string, error) {
+	if org, _ := cmd.Flags().GetString(OrgFlag); org != "" {
+		return org, nil
+	}
+	return c.DefaultOrg(cmd.Context())
+}
+
+// NewIAMClientForOrg is NewIAMClient plus the org selected by --org
+func NewIAMClientForOrg(cmd *cobra.Command) (*iam.Client, string, error) {
+	c, err := NewIAMClient(cmd)
+	if err != nil {
+		return nil, "", err
+	}
+	org, err := OrgID(cmd, c)
+	if err != nil {
+		return nil, "", err
+	}
+	return c, org, nil
+}
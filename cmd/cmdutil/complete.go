@@ -0,0 +1,229 @@
+package cmdutil
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"iv/pkg/cache"
+	"iv/pkg/config"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/rest"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// completionTTL keeps tab fast while still picking up new resources soon
+	completionTTL = 60 * time.Second
+	// completionTimeout bounds a live lookup so a slow vRA never hangs the shell
+	completionTimeout = 5 * time.Second
+)
+
+// candidate is a completion value and the hint shown next to it
+type candidate struct {
+	Value       string `json:"v"`
+	Description string `json:"d,omitempty"`
+}
+
+type fetchFn func(ctx context.Context, c *rest.Client) ([]candidate, error)
+
+// completeFrom serves candidates for kind from the on-disk cache of the
+// active profile, falling back to a live lookup
+func completeFrom(cmd *cobra.Command, kind, toComplete string, fetch fetchFn) ([]string, cobra.ShellCompDirective) {
+	p, err := Profile(cmd)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ns := "completion/" + p.Name
+	var cands []candidate
+	if !cache.Get(ns, kind, completionTTL, &cands) {
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+		cmd.SetContext(ctx)
+		c, err := ClientForProfile(cmd, p)
+		if err == nil {
+			cands, err = fetch(ctx, c)
+		}
+		if err != nil {
+			cobra.CompDebugln(err.Error(), true)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		_ = cache.Put(ns, kind, cands)
+	}
+	var out []string
+	for _, c := range cands {
+		if !strings.HasPrefix(c.Value, toComplete) {
+			continue
+		}
+		if c.Description != "" {
+			out = append(out, c.Value+"\t"+c.Description)
+		} else {
+			out = append(out, c.Value)
+		}
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteProfiles completes profile names from the config file
+func CompleteProfiles(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	var out []string
+	for name, p := range cfg.Profiles {
+		if strings.HasPrefix(name, toComplete) {
+			out = append(out, name+"\t"+p.Server)
+		}
+	}
+	return out, cobra.ShellCompDirectiveNoFileComp
+}
+
+// CompleteProjects completes project names
+func CompleteProjects(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "projects", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		projects, err := iaas.New(c).ListProjects(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, p := range projects {
+			cands = append(cands, candidate{Value: p.Name, Description: p.ID})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteMachines completes machine names and machine IDs
+func CompleteMachines(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "machines", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		machines, err := iaas.New(c).ListMachines(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, m := range machines {
+			cands = append(cands,
+				candidate{Value: m.Name, Description: m.ID},
+				candidate{Value: m.ID, Description: m.Name})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteZones completes cloud zone names
+func CompleteZones(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "zones", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		zones, err := iaas.New(c).ListZones(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, z := range zones {
+			cands = append(cands, candidate{Value: z.Name, Description: z.ID})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteDisks completes block device IDs
+func CompleteDisks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "disks", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		disks, err := iaas.New(c).ListBlockDevices(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, d := range disks {
+			cands = append(cands, candidate{Value: d.ID, Description: d.Name})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteCloudAccounts completes cloud account names
+func CompleteCloudAccounts(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "cloud-accounts", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		accounts, err := iaas.New(c).ListCloudAccounts(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, a := range accounts {
+			cands = append(cands, candidate{Value: a.Name, Description: a.CloudAccountType})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteDeployments completes deployment names
+func CompleteDeployments(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "deployments", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		deployments, err := iaas.New(c).ListDeployments(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, d := range deployments {
+			cands = append(cands, candidate{Value: d.Name, Description: d.ID})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteLoadBalancers completes load balancers names
+func CompleteLoadBalancers(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "load-balancers", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		lbs, err := iaas.New(c).ListLoadBalancers(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, lb := range lbs {
+			cands = append(cands, candidate{Value: lb.Name, Description: lb.Address})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteSecurityGroups completes security groups names
+func CompleteSecurityGroups(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "security-groups", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		groups, err := iaas.New(c).ListSecurityGroups(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, g := range groups {
+			cands = append(cands, candidate{Value: g.Name, Description: g.ProjectID})
+		}
+		return cands, nil
+	})
+}
+
+// CompleteOrgs completes the IDs of the orgs the logged in user belongs to
+func CompleteOrgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return completeFrom(cmd, "orgs", toComplete, func(ctx context.Context, c *rest.Client) ([]candidate, error) {
+		var orgs struct {
+			Items []struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				DisplayName string `json:"displayName"`
+			} `json:"items"`
+		}
+		if err := c.Get(ctx, "/csp/gateway/am/api/loggedin/user/orgs", nil, &orgs); err != nil {
+			return nil, err
+		}
+		var cands []candidate
+		for _, o := range orgs.Items {
+			desc := o.DisplayName
+			if desc == "" {
+				desc = o.Name
+			}
+			cands = append(cands, candidate{Value: o.ID, Description: desc})
+		}
+		return cands, nil
+	})
+}
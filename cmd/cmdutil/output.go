@@ -0,0 +1,53 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+)
+
+// AddOutputFlag registers -o/--output with the given formats, the first is the default
+func AddOutputFlag(cmd *cobra.Command, target *string, formats ...string) {
+	if len(formats) == 0 {
+		formats = []string{OutputTable, OutputJSON}
+	}
+	cmd.Flags().StringVarP(target, "output", "o", formats[0], "output format: "+strings.Join(formats, "|"))
+	cmd.RegisterFlagCompletionFunc("output", cobra.FixedCompletions(formats, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// PrintJSON writes v as indented JSON
+func PrintJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// PrintTable writes rows aligned under an upper-cased header
+func PrintTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.ToUpper(strings.Join(header, "\t")))
+	for _, r := range rows {
+		fmt.Fprintln(tw, strings.Join(r, "\t"))
+	}
+	return tw.Flush()
+}
+
+// Print renders v as JSON or as the table built by rows
+func Print(w io.Writer, format string, v any, header []string, rows func() [][]string) error {
+	switch format {
+	case OutputJSON:
+		return PrintJSON(w, v)
+	case OutputTable, "":
+		return PrintTable(w, header, rows())
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
@@ -0,0 +1,127 @@
+package cmdutil
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// ErrEcho is returned by Secret when the terminal cannot hide the answer,
+// the caller should point to a flag, environment variable or stdin instead
+var ErrEcho = errors.New("refusing to read a secret that would be echoed")
+
+// Prompter asks for values on stdin, prompts go to stderr so stdout stays
+// usable for output; a prompt returns the context error as soon as the
+// command's context is cancelled, e.g. by ctrl-c
+type Prompter struct {
+	ctx context.Context
+	in  *bufio.Reader
+	out io.Writer
+	fd  int
+	tty bool
+}
+
+// NewPrompter reads from the command's stdin
+func NewPrompter(cmd *cobra.Command) *Prompter {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	in := cmd.InOrStdin()
+	p := &Prompter{ctx: ctx, in: bufio.NewReader(in), out: cmd.ErrOrStderr(), fd: -1}
+	if f, ok := in.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		p.fd, p.tty = int(f.Fd()), true
+	}
+	return p
+}
+
+// Interactive reports whether stdin is a terminal
+func (p *Prompter) Interactive() bool {
+	return p.tty
+}
+
+// Ask prints label and reads a line, def is returned for an empty answer
+func (p *Prompter) Ask(label, def string) (string, error) {
+	if def != "" {
+		fmt.Fprintf(p.out, "%s [%s]: ", label, def)
+	} else {
+		fmt.Fprintf(p.out, "%s: ", label)
+	}
+	s, err := p.line()
+	if s == "" {
+		s = def
+	}
+	return s, err
+}
+
+// Secret is Ask without echoing the answer when stdin is a terminal, a
+// terminal that cannot turn off echo gives ErrEcho before anything is read
+func (p *Prompter) Secret(label string) (string, error) {
+	if !p.tty {
+		fmt.Fprintf(p.out, "%s: ", label)
+		return p.line()
+	}
+	state, err := term.GetState(p.fd)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrEcho, err)
+	}
+	fmt.Fprintf(p.out, "%s: ", label)
+	defer fmt.Fprintln(p.out)
+	s, err := wait(p.ctx, func() (string, error) {
+		b, err := term.ReadPassword(p.fd)
+		return string(b), err
+	})
+	if p.ctx.Err() != nil {
+		// the read is left blocked with echo off, turn it back on
+		term.Restore(p.fd, state)
+	}
+	return s, err
+}
+
+func (p *Prompter) line() (string, error) {
+	return wait(p.ctx, func() (string, error) {
+		s, err := p.in.ReadString('\n')
+		if err == io.EOF && s != "" {
+			err = nil
+		}
+		return strings.TrimRight(s, "\r\n"), err
+	})
+}
+
+// wait runs a blocking read and gives up on it when ctx is done, the read is
+// abandoned as the command is ending
+func wait(ctx context.Context, read func() (string, error)) (string, error) {
+	type result struct {
+		s   string
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		s, err := read()
+		done <- result{s, err}
+	}()
+	select {
+	case r := <-done:
+		return r.s, r.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// Confirm asks a yes/no question, anything but y or yes is a no
+func (p *Prompter) Confirm(label string) (bool, error) {
+	fmt.Fprintf(p.out, "%s [y/N]: ", label)
+	s, err := p.line()
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	s = strings.ToLower(strings.TrimSpace(s))
+	return s == "y" || s == "yes", nil
+}
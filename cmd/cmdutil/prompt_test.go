@@ -0,0 +1,55 @@
+package cmdutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func prompter(ctx context.Context, in io.Reader) (*Prompter, *strings.Builder) {
+	var out strings.Builder
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	cmd.SetIn(in)
+	cmd.SetErr(&out)
+	return NewPrompter(cmd), &out
+}
+
+func TestPrompterReads(t *testing.T) {
+	p, out := prompter(context.Background(), strings.NewReader("\nalice\r\ny\ns3cret"))
+	if v, err := p.Ask("Host", "vc01"); err != nil || v != "vc01" {
+		t.Errorf("Ask() = %q, %v, want the default", v, err)
+	}
+	if v, err := p.Ask("User", ""); err != nil || v != "alice" {
+		t.Errorf("Ask() = %q, %v", v, err)
+	}
+	if ok, err := p.Confirm("Apply?"); err != nil || !ok {
+		t.Errorf("Confirm() = %v, %v", ok, err)
+	}
+	// stdin that is not a terminal is read like any other answer
+	if v, err := p.Secret("Password"); err != nil || v != "s3cret" {
+		t.Errorf("Secret() = %q, %v", v, err)
+	}
+	if want := "Host [vc01]: User: Apply? [y/N]: Password: "; out.String() != want {
+		t.Errorf("prompts = %q, want %q", out.String(), want)
+	}
+}
+
+func TestPrompterCancel(t *testing.T) {
+	in, w := io.Pipe()
+	defer w.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	p, _ := prompter(ctx, in)
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if _, err := p.Secret("Password"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Secret() = %v, want it to return on cancel", err)
+	}
+	if _, err := p.Confirm("Apply?"); !errors.Is(err, context.Canceled) {
+		t.Errorf("Confirm() = %v, want it to return on cancel", err)
+	}
+}
@@ -0,0 +1,31 @@
+package cmdutil
+
+import (
+	"fmt"
+
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+// AddWaitFlag registers --wait, on by default
+func AddWaitFlag(cmd *cobra.Command, wait *bool) {
+	cmd.Flags().BoolVar(wait, "wait", true, "wait for the request to finish")
+}
+
+// FinishRequest waits for an asynchronous request when asked to and prints
+// its final state
+func FinishRequest(cmd *cobra.Command, c *iaas.Client, t *iaas.RequestTracker, wait bool) error {
+	if wait {
+		var err error
+		if t, err = c.Wait(cmd.Context(), t); err != nil {
+			return err
+		}
+	}
+	if t.ID == "" {
+		fmt.Fprintln(cmd.OutOrStdout(), "done")
+		return nil
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "request %s %s\n", t.ID, t.Status)
+	return nil
+}
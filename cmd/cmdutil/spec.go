@@ -0,0 +1,35 @@
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LoadSpec reads an API specification from a YAML or JSON file into v, with
+// the field names of the API; unknown fields are an error so typos do not
+// get lost
+func LoadSpec(path string, v any) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var doc any
+	if err := yaml.NewDecoder(f).Decode(&doc); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
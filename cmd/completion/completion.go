@@ -0,0 +1,63 @@
+package completion
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCompletionCommand generates shell completion scripts for root
+// resource names (projects, machines, zones, orgs) are looked up live against
+// the active profile and cached for a minute under ~/.iv/cache/completion
+func NewCompletionCommand(root *cobra.Command) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "completion",
+		Short: "generate the autocompletion script for the specified shell",
+	}
+
+	bash := &cobra.Command{
+		Use:   "bash",
+		Short: "generate the autocompletion script for bash",
+		Long: `Load completions in the current shell with:
+
+  source <(iv completion bash)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.GenBashCompletionV2(cmd.OutOrStdout(), true)
+		},
+	}
+	zsh := &cobra.Command{
+		Use:   "zsh",
+		Short: "generate the autocompletion script for zsh",
+		Long: `Load completions in the current shell with:
+
+  source <(iv completion zsh)`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.GenZshCompletion(cmd.OutOrStdout())
+		},
+	}
+	fish := &cobra.Command{
+		Use:   "fish",
+		Short: "generate the autocompletion script for fish",
+		Long: `Load completions in the current shell with:
+
+  iv completion fish | source`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.GenFishCompletion(cmd.OutOrStdout(), true)
+		},
+	}
+	powershell := &cobra.Command{
+		Use:   "powershell",
+		Short: "generate the autocompletion script for powershell",
+		Long: `Load completions in the current shell with:
+
+  iv completion powershell | Out-String | Invoke-Expression`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return root.GenPowerShellCompletionWithDesc(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.AddCommand(bash, zsh, fish, powershell)
+	return cmd
+}
@@ -0,0 +1,191 @@
+package deployments
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/report"
+
+	"github.com/spf13/cobra"
+)
+
+func NewDeploymentsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deployments",
+		Aliases: []string{"deployment"},
+		Short:   "list, delete and clean up IaaS deployments",
+	}
+	cmd.AddCommand(newListCommand(), newGetCommand(), newDeleteCommand(), newCleanupCommand())
+	return cmd
+}
+
+var deploymentHeader = []string{"id", "name", "project", "created", "owner"}
+
+func deploymentRows(all []iaas.Deployment) [][]string {
+	var rows [][]string
+	for _, d := range all {
+		rows = append(rows, []string{d.ID, d.Name, d.ProjectID, d.CreatedAt, d.Owner})
+	}
+	return rows
+}
+
+func newListCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "list [--filter ...]",
+		Short: "list deployments",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := c.ListDeployments(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, deploymentHeader, func() [][]string { return deploymentRows(all) })
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"projectId eq '<id>'\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <deployment>",
+		Short:             "show a deployment by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDeployments,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			d, err := c.FindDeployment(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, d, deploymentHeader, func() [][]string { return deploymentRows([]iaas.Deployment{*d}) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var wait, force bool
+	cmd := &cobra.Command{
+		Use:               "delete <deployment>",
+		Short:             "delete a deployment and its resources by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDeployments,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			d, err := c.FindDeployment(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.DeleteDeployment(cmd.Context(), d.ID, force)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "remove the deployment from vRA even if deleting its resources fails")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newCleanupCommand() *cobra.Command {
+	var filter, olderThan, output string
+	var empty, yes, dryRun, wait bool
+	cmd := &cobra.Command{
+		Use:   "cleanup --empty [--older-than 1d] [--filter ...]",
+		Short: "delete deployments that have no resources left",
+		Long: `Find the deployments that no machine, network, block device or load
+balancer belongs to any more and delete them. Only deployments created before
+--older-than (a day by default) are considered, younger ones may still be
+provisioning. The deployments are listed and deleted after confirmation;
+--yes skips the question and --dry-run only lists them.`,
+		Example: `  iv deployments cleanup --empty --older-than 30d --dry-run
+  iv deployments cleanup --empty --filter "projectId eq '<id>'" --yes`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !empty {
+				return errors.New("cleanup needs a selection, use --empty")
+			}
+			age, err := cmdutil.ParseAge(olderThan)
+			if err != nil {
+				return err
+			}
+			// deployments still provisioning have no resources yet either
+			if age <= 0 {
+				return errors.New("--older-than must be longer than 0, new deployments may still be provisioning")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := c.ListDeployments(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			found, err := report.EmptyDeployments(cmd.Context(), c, all, time.Now().Add(-age))
+			if err != nil {
+				return err
+			}
+			if err := cmdutil.Print(cmd.OutOrStdout(), output, found, deploymentHeader, func() [][]string { return deploymentRows(found) }); err != nil {
+				return err
+			}
+			if len(found) == 0 || dryRun {
+				return nil
+			}
+			if !yes {
+				p := cmdutil.NewPrompter(cmd)
+				if !p.Interactive() {
+					return errors.New("not a terminal, use --yes to delete without confirmation")
+				}
+				ok, err := p.Confirm(fmt.Sprintf("delete %d empty deployments", len(found)))
+				if err != nil || !ok {
+					return err
+				}
+			}
+
+			// all deletes are started first, then waited for
+			var errs []error
+			var started []*iaas.RequestTracker
+			for _, d := range found {
+				t, err := c.DeleteDeployment(cmd.Context(), d.ID, false)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", d.Name, err))
+					continue
+				}
+				started = append(started, t)
+			}
+			for _, t := range started {
+				if err := cmdutil.FinishRequest(cmd, c, t, wait); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+	cmd.Flags().BoolVar(&empty, "empty", false, "select deployments without machines, networks, block devices and load balancers")
+	cmd.Flags().StringVar(&olderThan, "older-than", "1d", "only deployments created longer ago than this, e.g. 30d")
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter on the deployments to check")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "delete without asking")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only list the deployments that would be deleted")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
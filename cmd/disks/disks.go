@@ -0,0 +1,329 @@
+package disks
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+func NewDisksCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "disks",
+		Aliases: []string{"disk"},
+		Short:   "create, attach, resize and snapshot block devices",
+	}
+	cmd.AddCommand(newListCommand(), newGetCommand(), newAttachCommand(), newDetachCommand(),
+		newResizeCommand(), newDeleteCommand(), newPromoteCommand(), newRevertCommand(), newSnapshotsCommand())
+	return cmd
+}
+
+func diskRows(disks []iaas.BlockDevice) [][]string {
+	var rows [][]string
+	for _, d := range disks {
+		rows = append(rows, []string{d.ID, d.Name, strconv.Itoa(d.CapacityInGB), d.Status, d.Type, strconv.FormatBool(d.Persistent)})
+	}
+	return rows
+}
+
+var diskHeader = []string{"id", "name", "gb", "status", "type", "persistent"}
+
+func newListCommand() *cobra.Command {
+	var machine, filter, output string
+	cmd := &cobra.Command{
+		Use:   "list [--machine <machine>] [--filter ...]",
+		Short: "list block devices, or the disks of one machine",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			var disks []iaas.BlockDevice
+			if machine != "" {
+				m, err := c.FindMachine(cmd.Context(), machine)
+				if err != nil {
+					return err
+				}
+				disks, err = c.ListMachineDisks(cmd.Context(), m.ID)
+				if err != nil {
+					return err
+				}
+			} else if disks, err = c.ListBlockDevices(cmd.Context(), &iaas.ListOptions{Filter: filter}); err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, disks, diskHeader, func() [][]string { return diskRows(disks) })
+		},
+	}
+	cmd.Flags().StringVar(&machine, "machine", "", "machine name or id")
+	cmd.RegisterFlagCompletionFunc("machine", cmdutil.CompleteMachines)
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"persistent eq true\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <disk-id>",
+		Short:             "show a block device",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			d, err := c.GetBlockDevice(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, d, diskHeader, func() [][]string { return diskRows([]iaas.BlockDevice{*d}) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newAttachCommand() *cobra.Command {
+	var spec iaas.BlockDeviceSpecification
+	var disk, output string
+	cmd := &cobra.Command{
+		Use:   "attach <machine> (--size GB | --disk <disk-id>)",
+		Short: "attach a new disk of --size GB, or an existing disk, to a machine",
+		Long: `Attach a disk to a machine. With --size a disk is created in the project and
+deployment of the machine, and attached once it exists; with --disk an
+existing block device is attached. Both wait for the requests to finish.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (spec.CapacityInGB > 0) == (disk != "") {
+				return fmt.Errorf("set either --size or --disk")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			m, err := c.FindMachine(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			var d *iaas.BlockDevice
+			if disk != "" {
+				t, err := c.AttachMachineDisk(ctx, m.ID, iaas.DiskAttachmentSpecification{BlockDeviceID: disk})
+				if err == nil {
+					_, err = c.Wait(ctx, t)
+				}
+				if err != nil {
+					return err
+				}
+				if d, err = c.GetBlockDevice(ctx, disk); err != nil {
+					return err
+				}
+			} else {
+				if spec.Name == "" {
+					spec.Name = fmt.Sprintf("%s-disk-%d", m.Name, time.Now().Unix())
+				}
+				if d, err = c.AttachNewDisk(ctx, m, spec); err != nil {
+					return err
+				}
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, d, diskHeader, func() [][]string { return diskRows([]iaas.BlockDevice{*d}) })
+		},
+	}
+	cmd.Flags().IntVar(&spec.CapacityInGB, "size", 0, "capacity of a new disk in GB")
+	cmd.Flags().StringVar(&spec.Name, "name", "", "name of the new disk, defaults to <machine>-disk-<time>")
+	cmd.Flags().BoolVar(&spec.Persistent, "persistent", false, "keep the new disk when the machine is deleted")
+	cmd.Flags().BoolVar(&spec.Encrypted, "encrypted", false, "encrypt the new disk")
+	cmd.Flags().StringVar(&disk, "disk", "", "attach this existing disk instead")
+	cmd.RegisterFlagCompletionFunc("disk", cmdutil.CompleteDisks)
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDetachCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "detach <machine> <disk-id>",
+		Short:             "detach a disk from a machine",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			m, err := c.FindMachine(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.DetachMachineDisk(cmd.Context(), m.ID, args[1])
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newResizeCommand() *cobra.Command {
+	var size int
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "resize <disk-id> --size GB",
+		Short:             "change the capacity of a disk",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := c.ResizeBlockDevice(cmd.Context(), args[0], size)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().IntVar(&size, "size", 0, "new capacity in GB")
+	cmd.MarkFlagRequired("size")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var purge, wait bool
+	cmd := &cobra.Command{
+		Use:               "delete <disk-id>",
+		Short:             "delete a disk",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := c.DeleteBlockDevice(cmd.Context(), args[0], purge)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().BoolVar(&purge, "purge", false, "also remove the disk from the datastore")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newPromoteCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "promote <disk-id>",
+		Short:             "consolidate a disk created from a linked clone",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := c.PromoteDisk(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newRevertCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "revert <disk-id> <snapshot-id>",
+		Short:             "revert a disk to one of its snapshots",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := c.RevertDisk(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newSnapshotsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "take and list disk snapshots",
+	}
+
+	var spec iaas.DiskSnapshotSpecification
+	var wait bool
+	create := &cobra.Command{
+		Use:               "create <disk-id>",
+		Short:             "take a snapshot of a disk",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			t, err := c.CreateDiskSnapshot(cmd.Context(), args[0], spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	create.Flags().StringVar(&spec.Name, "name", "", "snapshot name")
+	create.Flags().StringVar(&spec.Description, "description", "", "snapshot description")
+	cmdutil.AddWaitFlag(create, &wait)
+
+	var output string
+	list := &cobra.Command{
+		Use:               "list <disk-id>",
+		Short:             "list the snapshots of a disk",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteDisks,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			snaps, err := c.ListDiskSnapshots(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, snaps, []string{"id", "name", "created"}, func() [][]string {
+				var rows [][]string
+				for _, s := range snaps {
+					rows = append(rows, []string{s.ID, s.Name, s.CreatedAt})
+				}
+				return rows
+			})
+		},
+	}
+	cmdutil.AddOutputFlag(list, &output)
+
+	cmd.AddCommand(create, list)
+	return cmd
+}
@@ -0,0 +1,58 @@
+package drift
+
+import (
+	"fmt"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/manifest"
+
+	"github.com/spf13/cobra"
+)
+
+func NewDriftCommand() *cobra.Command {
+	var files []string
+	var output string
+	cmd := &cobra.Command{
+		Use:   "drift -f FILE|DIR",
+		Short: "compare manifests with vRA and fail on any difference",
+		Long: `Compare manifests with the live objects and report:
+
+  drifted    declared objects whose declared fields differ
+  missing    declared objects that do not exist
+  unmanaged  objects of a declared kind that no manifest declares
+
+Projects are read through both the IaaS API and the Project Service. The exit
+status is non-zero when anything drifted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			objs, err := manifest.Load(files...)
+			if err != nil {
+				return err
+			}
+			r, _, err := cmdutil.NewResolver(cmd)
+			if err != nil {
+				return err
+			}
+			d, err := manifest.CheckDrift(cmd.Context(), r, objs)
+			if err != nil {
+				return err
+			}
+			if output == cmdutil.OutputJSON {
+				if err := cmdutil.PrintJSON(cmd.OutOrStdout(), d); err != nil {
+					return err
+				}
+			} else {
+				d.Print(cmd.OutOrStdout())
+			}
+			if d.Found() {
+				return fmt.Errorf("drift detected: %d drifted, %d missing, %d unmanaged",
+					len(d.Drifted), len(d.Missing), len(d.Unmanaged))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVarP(&files, "filename", "f", nil, "manifest file or directory, repeatable")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "report format: text|json")
+	return cmd
+}
@@ -0,0 +1,109 @@
+package events
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/events"
+
+	"github.com/spf13/cobra"
+)
+
+const outputJSONL = "jsonl"
+
+func NewEventsCommand() *cobra.Command {
+	var since, output string
+	var types, sinks []string
+	var follow bool
+	var interval time.Duration
+	cmd := &cobra.Command{
+		Use:   "events [--since 1h] [--type ERROR] [--follow]",
+		Short: "show the IaaS event log, or follow it",
+		Long: `Show the events of the IaaS event log since a point in time. With --follow
+the log is polled until interrupted and new events are printed as they come,
+every event once.
+
+--sink file:<path> appends the events as JSON lines to a file and --sink
+syslog sends them to the local syslog, with the severity of the event type.`,
+		Example: `  iv events --since 30m --type ERROR --type WARNING
+  iv events -f -o jsonl --sink file:/var/log/vra-events.jsonl`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if output != cmdutil.OutputTable && output != outputJSONL {
+				return fmt.Errorf("unknown output format %q", output)
+			}
+			age, err := cmdutil.ParseAge(since)
+			if err != nil {
+				return err
+			}
+			var outs []events.Sink
+			for _, spec := range sinks {
+				s, err := events.OpenSink(spec)
+				if err != nil {
+					return err
+				}
+				defer s.Close()
+				outs = append(outs, s)
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			r := events.NewReader(c, time.Now().Add(-age))
+			r.Types, r.Interval = types, interval
+
+			stdout := cmd.OutOrStdout()
+			var jsonl events.Sink
+			if output == outputJSONL {
+				jsonl = events.NewJSONLines(stdout)
+			} else {
+				printHeader(stdout)
+			}
+			emit := func(e iaas.EventLog) error {
+				if jsonl != nil {
+					if err := jsonl.Write(e); err != nil {
+						return err
+					}
+				} else {
+					fmt.Fprintln(stdout, events.Line(e))
+				}
+				var errs []error
+				for _, s := range outs {
+					errs = append(errs, s.Write(e))
+				}
+				return errors.Join(errs...)
+			}
+
+			if !follow {
+				fresh, err := r.Poll(cmd.Context())
+				if err != nil {
+					return err
+				}
+				for _, e := range fresh {
+					if err := emit(e); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			return r.Follow(cmd.Context(), emit, func(err error) {
+				fmt.Fprintf(cmd.ErrOrStderr(), "poll failed, retrying: %v\n", err)
+			})
+		},
+	}
+	cmd.Flags().StringVar(&since, "since", "1h", "show events newer than this, e.g. 30m, 12h or 2d")
+	cmd.Flags().StringSliceVar(&types, "type", nil, "only events of this type (e.g. ERROR, WARNING, INFO), repeatable")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "keep polling for new events until interrupted")
+	cmd.Flags().DurationVar(&interval, "interval", events.DefaultInterval, "poll interval of --follow")
+	cmd.Flags().StringSliceVar(&sinks, "sink", nil, "also write events to file:<path> (JSON lines) or syslog, repeatable")
+	cmdutil.AddOutputFlag(cmd, &output, cmdutil.OutputTable, outputJSONL)
+	return cmd
+}
+
+func printHeader(w io.Writer) {
+	fmt.Fprintln(w, events.Line(iaas.EventLog{UpdatedAt: "TIME", EventLogType: "TYPE", Owner: "OWNER", Description: "DESCRIPTION"}))
+}
@@ -0,0 +1,78 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/manifest"
+
+	"github.com/spf13/cobra"
+)
+
+func NewExportCommand() *cobra.Command {
+	var kinds []string
+	var dir string
+	cmd := &cobra.Command{
+		Use:   "export [--kinds projects,zones,...] [-o DIR]",
+		Short: "write live IaaS objects as manifests for iv apply",
+		Long: `Write live IaaS objects as manifests for iv apply.
+
+Server generated fields (id, createdAt, _links, orgId, owner, ...) are left out
+and ids of other objects are written as ref:<Kind>/<name>. With -o every kind
+goes to DIR/<kind>.yaml, otherwise everything is written to stdout.
+
+Kinds: ` + strings.Join(manifest.Aliases(), ", "),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			r := manifest.NewResolver(c)
+			if dir == "" {
+				objs, err := manifest.Export(cmd.Context(), r, kinds)
+				if err != nil {
+					return err
+				}
+				return manifest.Encode(cmd.OutOrStdout(), objs)
+			}
+			if err := os.MkdirAll(dir, 0o755); err != nil {
+				return err
+			}
+			for _, kind := range kinds {
+				objs, err := manifest.Export(cmd.Context(), r, []string{kind})
+				if err != nil {
+					return err
+				}
+				file := filepath.Join(dir, kind+".yaml")
+				if err := write(file, objs); err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.ErrOrStderr(), "%s: %d object(s)\n", file, len(objs))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&kinds, "kinds", manifest.Aliases(), "kinds to export")
+	cmd.Flags().StringVarP(&dir, "output", "o", "", "directory to write one file per kind to")
+	cmd.MarkFlagDirname("output")
+	cmd.RegisterFlagCompletionFunc("kinds", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return manifest.Aliases(), cobra.ShellCompDirectiveNoFileComp
+	})
+	return cmd
+}
+
+func write(file string, objs []*manifest.Object) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := manifest.Encode(f, objs); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
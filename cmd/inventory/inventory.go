@@ -0,0 +1,208 @@
+package inventory
+
+import (
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/inventory"
+
+	"github.com/spf13/cobra"
+)
+
+func NewInventoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inventory",
+		Short: "keep a local copy of the IaaS resources and query it offline",
+		Long: `Keep a local copy of machines, networks, block devices, deployments,
+projects, zones, regions and fabric computes under ~/.iv/inventory/<profile>,
+one JSON lines file per kind plus an index, and query it without vRA.`,
+	}
+	cmd.PersistentFlags().String("dir", "", "inventory directory, defaults to ~/.iv/inventory/<profile>")
+	cmd.AddCommand(newSyncCommand(), newStatusCommand(), newQueryCommand())
+	return cmd
+}
+
+// open returns the store of the active profile, or of --dir
+func open(cmd *cobra.Command) (*inventory.Store, error) {
+	if dir, _ := cmd.Flags().GetString("dir"); dir != "" {
+		return inventory.Open(dir), nil
+	}
+	p, err := cmdutil.Profile(cmd)
+	if err != nil {
+		return nil, err
+	}
+	dir, err := inventory.DefaultDir(p.Name)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.Open(dir), nil
+}
+
+func kindCompletion() func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return cobra.FixedCompletions(inventory.KindNames(), cobra.ShellCompDirectiveNoFileComp)
+}
+
+func newSyncCommand() *cobra.Command {
+	var kinds []string
+	var full bool
+	var output string
+	cmd := &cobra.Command{
+		Use:   "sync [--kind machines]... [--full]",
+		Short: "copy the IaaS resources into the local inventory",
+		Long: `Page through the IaaS collections and store them locally. Collections that
+support $filter only fetch what was updated since the last sync; deletions
+are noticed by comparing the count with the server, which falls back to a
+full read. The other collections are read in full every time.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var selected []inventory.Kind
+			for _, name := range kinds {
+				k, ok := inventory.LookupKind(name)
+				if !ok {
+					return fmt.Errorf("unknown kind %q (%s)", name, strings.Join(inventory.KindNames(), ", "))
+				}
+				selected = append(selected, k)
+			}
+			store, err := open(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := cmdutil.Profile(cmd)
+			if err != nil {
+				return err
+			}
+			c, err := cmdutil.ClientForProfile(cmd, p)
+			if err != nil {
+				return err
+			}
+			var results []inventory.Result
+			s := &inventory.Syncer{Client: iaas.New(c), Store: store, Server: p.Server, Full: full}
+			s.OnResult = func(r inventory.Result) {
+				results = append(results, r)
+				if output == cmdutil.OutputTable {
+					fmt.Fprintf(cmd.ErrOrStderr(), "%s: %s, %d fetched\n", r.Kind, r.Mode, r.Fetched)
+				}
+			}
+			syncErr := s.Sync(cmd.Context(), selected)
+			err = cmdutil.Print(cmd.OutOrStdout(), output, results, []string{"kind", "mode", "fetched", "count", "duration", "error"}, func() [][]string {
+				var rows [][]string
+				for _, r := range results {
+					rows = append(rows, []string{r.Kind, r.Mode, fmt.Sprint(r.Fetched), fmt.Sprint(r.Count), r.Duration.String(), r.Error})
+				}
+				return rows
+			})
+			if syncErr != nil {
+				return syncErr
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringSliceVar(&kinds, "kind", nil, "kind to sync, repeatable, all when unset")
+	cmd.RegisterFlagCompletionFunc("kind", kindCompletion())
+	cmd.Flags().BoolVar(&full, "full", false, "read every collection in full")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newStatusCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "show what the local inventory holds and when it was synced",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			store, err := open(cmd)
+			if err != nil {
+				return err
+			}
+			idx, err := store.Index()
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, idx, []string{"kind", "count", "synced", "newest update"}, func() [][]string {
+				var rows [][]string
+				for _, name := range inventory.KindNames() {
+					if st, ok := idx.Kinds[name]; ok {
+						rows = append(rows, []string{name, fmt.Sprint(st.Count), st.SyncedAt.Local().Format("2006-01-02 15:04:05"), st.UpdatedAt})
+					} else {
+						rows = append(rows, []string{name, "-", "never", ""})
+					}
+				}
+				return rows
+			})
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newQueryCommand() *cobra.Command {
+	var where, columns []string
+	var count bool
+	var output string
+	cmd := &cobra.Command{
+		Use:   "query <kind> [--where <cond>]... [--columns a,b]",
+		Short: "query the local inventory",
+		Long: `Query a kind of the local inventory. Conditions are path<op>value with the
+operators = and != (case insensitive), ~ (substring), and <, >, <=, >=
+(numeric when both sides are numbers). Paths are dotted field names like
+customProperties.datastoreName; tags read as key:value. A path starting with
+project., deployment. or region. follows projectId, deploymentId or
+externalRegionId to the referenced resource, so kinds can be joined.
+Columns are paths too.`,
+		Example: `  iv inventory query machines --where project.name=dev --where customProperties.datastoreName~ssd01
+  iv inventory query block-devices --where capacityInGB>=500 --columns name,capacityInGB,deployment.name
+  iv inventory query machines --where tags=env:prod --count`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: kindCompletion(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var conds []inventory.Condition
+			for _, w := range where {
+				c, err := inventory.ParseCondition(w)
+				if err != nil {
+					return err
+				}
+				conds = append(conds, c)
+			}
+			store, err := open(cmd)
+			if err != nil {
+				return err
+			}
+			db := inventory.NewDB(store)
+			found, err := db.Query(args[0], conds)
+			if err != nil {
+				return err
+			}
+			if count {
+				fmt.Fprintln(cmd.OutOrStdout(), len(found))
+				return nil
+			}
+			if len(columns) == 0 {
+				columns = []string{"id", "name"}
+				for _, c := range conds {
+					columns = append(columns, c.Path)
+				}
+			}
+			var rows [][]string
+			for _, o := range found {
+				row := make([]string, len(columns))
+				for i, col := range columns {
+					values, err := db.Values(o, col)
+					if err != nil {
+						return err
+					}
+					row[i] = strings.Join(values, ",")
+				}
+				rows = append(rows, row)
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, found, columns, func() [][]string { return rows })
+		},
+	}
+	cmd.Flags().StringArrayVarP(&where, "where", "w", nil, "condition path<op>value, repeatable, all must hold")
+	cmd.Flags().StringSliceVar(&columns, "columns", nil, "paths to show, defaults to id, name and the --where paths")
+	cmd.Flags().BoolVar(&count, "count", false, "only print the number of matches")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
@@ -0,0 +1,246 @@
+package ipam
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/cache"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/report"
+
+	"github.com/spf13/cobra"
+)
+
+func NewIPAMCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ipam",
+		Short: "allocate and release IP addresses of internal IP ranges",
+	}
+	cmd.AddCommand(newAllocateCommand(), newReleaseCommand(), newListCommand(), newUsageCommand(), newImportPackageCommand())
+	return cmd
+}
+
+var addressHeader = []string{"id", "address", "status", "allocation", "description"}
+
+func addressRows(addrs []iaas.NetworkIPAddress) [][]string {
+	var rows [][]string
+	for _, a := range addrs {
+		rows = append(rows, []string{a.ID, a.IPAddress, a.IPAddressStatus, a.IPAllocationType, a.Description})
+	}
+	return rows
+}
+
+func newAllocateCommand() *cobra.Command {
+	var spec iaas.IPAddressAllocateSpecification
+	var output string
+	cmd := &cobra.Command{
+		Use:   "allocate <range> (--count N | --ip ADDR...)",
+		Short: "reserve addresses in an IP range, e.g. for appliances",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if (spec.NumberOfIPs > 0) == (len(spec.IPAddresses) > 0) {
+				return fmt.Errorf("set either --count or --ip")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			r, err := c.FindNetworkIPRange(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.AllocateIPs(ctx, r.ID, spec)
+			if err == nil {
+				t, err = c.Wait(ctx, t)
+			}
+			if err != nil {
+				return err
+			}
+			var addrs []iaas.NetworkIPAddress
+			for _, id := range t.ResourceIDs() {
+				a, err := c.GetIPAddress(ctx, r.ID, id)
+				if err != nil {
+					return err
+				}
+				addrs = append(addrs, *a)
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, addrs, addressHeader, func() [][]string { return addressRows(addrs) })
+		},
+	}
+	cmd.Flags().IntVar(&spec.NumberOfIPs, "count", 0, "number of free addresses to allocate")
+	cmd.Flags().StringSliceVar(&spec.IPAddresses, "ip", nil, "specific addresses to allocate, repeatable")
+	cmd.Flags().StringVar(&spec.Description, "description", "", "what the addresses are for")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newReleaseCommand() *cobra.Command {
+	var ips []string
+	var unregistered, wait bool
+	cmd := &cobra.Command{
+		Use:   "release <range> --ip ADDR...",
+		Short: "release allocated addresses of an IP range",
+		Long: `Release addresses allocated with iv ipam allocate. With --unregistered the
+addresses vRA still holds for machines that no longer exist are released.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			r, err := c.FindNetworkIPRange(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			release := c.ReleaseIPs
+			if unregistered {
+				release = c.ReleaseUnregisteredIPs
+			}
+			t, err := release(cmd.Context(), r.ID, ips)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringSliceVar(&ips, "ip", nil, "addresses to release, repeatable")
+	cmd.MarkFlagRequired("ip")
+	cmd.Flags().BoolVar(&unregistered, "unregistered", false, "release addresses of machines unknown to vRA")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newListCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "list <range>",
+		Short: "list the allocated and released addresses of an IP range",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			r, err := c.FindNetworkIPRange(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			addrs, err := c.ListIPAddresses(cmd.Context(), r.ID)
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, addrs, addressHeader, func() [][]string { return addressRows(addrs) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newUsageCommand() *cobra.Command {
+	var filter, output string
+	var t report.Thresholds
+	cmd := &cobra.Command{
+		Use:   "usage [--warning 80] [--critical 90]",
+		Short: "capacity of every IP range with threshold levels",
+		Long: `Report total, allocated, available and released addresses per IP range and in
+total. A range whose used share (everything not available) reaches --warning
+or --critical percent is flagged with that level.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			rows, err := report.IPUsage(cmd.Context(), c, filter, t)
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, rows, report.IPUsageHeader, func() [][]string {
+				var out [][]string
+				for _, r := range rows {
+					out = append(out, r.Strings())
+				}
+				return out
+			})
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter selecting the ranges")
+	cmd.Flags().Float64Var(&t.Warning, "warning", 80, "used percent flagged as warning, 0 disables")
+	cmd.Flags().Float64Var(&t.Critical, "critical", 90, "used percent flagged as critical, 0 disables")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// uploadTTL is how long an unfinished package upload is remembered for resume
+const uploadTTL = 24 * time.Hour
+
+func newImportPackageCommand() *cobra.Command {
+	var chunkMiB int
+	var option, output string
+	cmd := &cobra.Command{
+		Use:   "import-package <bundle.zip>",
+		Short: "upload an IPAM provider package, resuming an interrupted upload",
+		Long: `Upload an IPAM integration package with the tus resumable upload protocol.
+The upload location is remembered for a day, running the command again for
+the same unchanged file continues from the offset the server already has.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			fi, err := f.Stat()
+			if err != nil {
+				return err
+			}
+			p, err := cmdutil.Profile(cmd)
+			if err != nil {
+				return err
+			}
+			c, err := cmdutil.ClientForProfile(cmd, p)
+			if err != nil {
+				return err
+			}
+
+			ns, key := "uploads/"+p.Name, uploadKey(args[0], fi)
+			u := &iaas.Upload{Size: fi.Size(), ChunkSize: int64(chunkMiB) << 20}
+			cache.Get(ns, key, uploadTTL, &u.URL)
+			stderr := cmd.ErrOrStderr()
+			u.Progress = func(offset, size int64) {
+				// remember the location once the server has it
+				cache.Put(ns, key, u.URL)
+				fmt.Fprintf(stderr, "\ruploaded %.1f of %.1f MiB", float64(offset)/(1<<20), float64(size)/(1<<20))
+			}
+			res, err := iaas.New(c).ImportIPAMPackage(cmd.Context(), u, f, option)
+			fmt.Fprintln(stderr)
+			if err != nil {
+				return err
+			}
+			cache.Delete(ns, key)
+			return cmdutil.Print(cmd.OutOrStdout(), output, res, []string{"provider", "name", "version"}, func() [][]string {
+				return [][]string{{res.ProviderID, res.ProviderName, res.ProviderVersion}}
+			})
+		},
+	}
+	cmd.Flags().IntVar(&chunkMiB, "chunk-size", iaas.DefaultChunkSize>>20, "upload chunk size in MiB")
+	cmd.Flags().StringVar(&option, "option", "", "import option passed to vRA, e.g. OVERWRITE")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// uploadKey identifies a file by path, size and modification time
+func uploadKey(name string, fi os.FileInfo) string {
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		abs = name
+	}
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%d|%d", abs, fi.Size(), fi.ModTime().UnixNano())))
+	return hex.EncodeToString(sum[:12])
+}
@@ -1,7 +1,31 @@
 package app
 
 import (
+	"iv/cmd/apply"
+	"iv/cmd/batch"
+	"iv/cmd/cloudaccounts"
+	"iv/cmd/cmdutil"
+	"iv/cmd/completion"
+	"iv/cmd/deployments"
+	"iv/cmd/disks"
+	"iv/cmd/drift"
+	"iv/cmd/events"
+	"iv/cmd/export"
+	"iv/cmd/inventory"
+	"iv/cmd/ipam"
+	"iv/cmd/lb"
 	"iv/cmd/login"
+	"iv/cmd/machines"
+	"iv/cmd/naming"
+	"iv/cmd/org"
+	"iv/cmd/projects"
+	"iv/cmd/rbac"
+	"iv/cmd/report"
+	"iv/cmd/sg"
+	"iv/cmd/snapshots"
+	"iv/cmd/tags"
+	"iv/cmd/user"
+	"iv/cmd/validate"
 	"iv/pkg/server"
 
 	"github.com/spf13/cobra"
@@ -15,17 +39,73 @@ func NewIVCommand(args []string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "iv",
 		Short: "iv is a go client to make REST api calls to server",
+		// errors of a running command are not usage errors, see usageOnInputErrors
+		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return Run(args)
 		},
 	}
+	cmd.PersistentFlags().String(cmdutil.ProfileFlag, "", "vRA profile from ~/.iv/config.json")
+	cmd.RegisterFlagCompletionFunc(cmdutil.ProfileFlag, cmdutil.CompleteProfiles)
+	cmd.CompletionOptions.DisableDefaultCmd = true
 
 	login := login.NewLoginCommand()
 	cmd.AddCommand(login)
+	cmd.AddCommand(batch.NewBatchCommand())
+	cmd.AddCommand(org.NewOrgCommand())
+	cmd.AddCommand(user.NewUserCommand())
+	cmd.AddCommand(rbac.NewRBACCommand())
+	cmd.AddCommand(report.NewReportCommand())
+	cmd.AddCommand(apply.NewApplyCommand())
+	cmd.AddCommand(apply.NewDiffCommand())
+	cmd.AddCommand(apply.NewDeleteCommand())
+	cmd.AddCommand(export.NewExportCommand())
+	cmd.AddCommand(drift.NewDriftCommand())
+	cmd.AddCommand(snapshots.NewSnapshotsCommand())
+	cmd.AddCommand(disks.NewDisksCommand())
+	cmd.AddCommand(ipam.NewIPAMCommand())
+	cmd.AddCommand(cloudaccounts.NewCloudAccountsCommand())
+	cmd.AddCommand(naming.NewNamingCommand())
+	cmd.AddCommand(tags.NewTagsCommand())
+	cmd.AddCommand(events.NewEventsCommand())
+	cmd.AddCommand(deployments.NewDeploymentsCommand())
+	cmd.AddCommand(lb.NewLBCommand())
+	cmd.AddCommand(sg.NewSGCommand())
+	cmd.AddCommand(machines.NewMachinesCommand())
+	cmd.AddCommand(inventory.NewInventoryCommand())
+	cmd.AddCommand(validate.NewValidateCommand())
+	cmd.AddCommand(projects.NewProjectsCommand())
+	cmd.AddCommand(completion.NewCompletionCommand(cmd))
+	usageOnInputErrors(cmd)
 
 	return cmd
 }
 
+// usageOnInputErrors prints the usage of a command when its flags or
+// arguments are wrong, which SilenceUsage otherwise suppresses
+func usageOnInputErrors(root *cobra.Command) {
+	root.SetFlagErrorFunc(func(c *cobra.Command, err error) error {
+		c.PrintErrln(c.UsageString())
+		return err
+	})
+	var wrap func(c *cobra.Command)
+	wrap = func(c *cobra.Command) {
+		if args := c.Args; args != nil {
+			c.Args = func(c *cobra.Command, a []string) error {
+				err := args(c, a)
+				if err != nil {
+					c.PrintErrln(c.UsageString())
+				}
+				return err
+			}
+		}
+		for _, sub := range c.Commands() {
+			wrap(sub)
+		}
+	}
+	wrap(root)
+}
+
 func Run(args []string) error {
 	return server.RunServer()
 }
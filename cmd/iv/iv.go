@@ -1,12 +1,19 @@
 package main
 
 import (
+	"context"
 	"iv/cmd/iv/app"
 	"os"
+	"os/signal"
 )
 
 func main() {
+	// cancel in-flight work on ctrl-c
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 	command := app.NewIVCommand(os.Args)
-	command.Execute()
-	// os.Exit(1)
+	if err := command.ExecuteContext(ctx); err != nil {
+		stop()
+		os.Exit(1)
+	}
 }
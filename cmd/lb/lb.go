@@ -0,0 +1,297 @@
+package lb
+
+import (
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+func NewLBCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lb",
+		Aliases: []string{"load-balancers"},
+		Short:   "create, scale and delete load balancers",
+	}
+	cmd.AddCommand(newListCommand(), newGetCommand(), newCreateCommand(), newScaleCommand(), newDeleteCommand())
+	return cmd
+}
+
+var lbHeader = []string{"id", "name", "address", "routes", "status"}
+
+func lbRows(all []iaas.LoadBalancer) [][]string {
+	var rows [][]string
+	for _, l := range all {
+		rows = append(rows, []string{l.ID, l.Name, l.Address, routes(l.Routes), l.ProvisioningStatus})
+	}
+	return rows
+}
+
+// routes shows routes as tcp:80->tcp:8080
+func routes(all []iaas.RouteConfiguration) string {
+	var out []string
+	for _, r := range all {
+		out = append(out, fmt.Sprintf("%s:%s->%s:%s", r.Protocol, r.Port, r.MemberProtocol, r.MemberPort))
+	}
+	return strings.Join(out, ",")
+}
+
+func newListCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "list [--filter ...]",
+		Short: "list load balancers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := c.ListLoadBalancers(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, lbHeader, func() [][]string { return lbRows(all) })
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"projectId eq '<id>'\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <load-balancer>",
+		Short:             "show a load balancer by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteLoadBalancers,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			l, err := c.FindLoadBalancer(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, l, lbHeader, func() [][]string { return lbRows([]iaas.LoadBalancer{*l}) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// targets resolves machine names or ids to target links
+func targets(cmd *cobra.Command, c *iaas.Client, machines []string) ([]string, error) {
+	var links []string
+	for _, ref := range machines {
+		m, err := c.FindMachine(cmd.Context(), ref)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, iaas.MachineLink(m.ID))
+	}
+	return links, nil
+}
+
+func parseRoutes(specs []string) ([]iaas.RouteConfiguration, error) {
+	var out []iaas.RouteConfiguration
+	for _, s := range specs {
+		r, err := iaas.ParseRoute(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func newCreateCommand() *cobra.Command {
+	var file, name, project, typ string
+	var networks, routeSpecs, machines []string
+	var wait, internetFacing bool
+	cmd := &cobra.Command{
+		Use:   "create (-f <spec> | --name <name> --project <project> --network <net> --route <route>)",
+		Short: "create a load balancer",
+		Long: `Create a load balancer from a specification file (YAML or JSON with the
+fields of the API) or from flags; flags override the file. Routes are
+protocol:port[:memberProtocol:memberPort] and are checked before anything is
+sent.`,
+		Example: `  iv lb create --name web-lb --project dev --network web-net --route http:80:http:8080 --target web-01 --target web-02
+  iv lb create -f web-lb.yaml`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var spec iaas.LoadBalancerSpecification
+			if file != "" {
+				if err := cmdutil.LoadSpec(file, &spec); err != nil {
+					return err
+				}
+			}
+			if name != "" {
+				spec.Name = name
+			}
+			if typ != "" {
+				spec.Type = typ
+			}
+			spec.InternetFacing = spec.InternetFacing || internetFacing
+			r, err := parseRoutes(routeSpecs)
+			if err != nil {
+				return err
+			}
+			spec.Routes = append(spec.Routes, r...)
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+			if spec.Name == "" {
+				return fmt.Errorf("a load balancer needs a name")
+			}
+
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			if project != "" {
+				p, err := c.FindProject(ctx, project)
+				if err != nil {
+					return err
+				}
+				spec.ProjectID = p.ID
+			}
+			if spec.ProjectID == "" {
+				return fmt.Errorf("a load balancer needs a project, use --project")
+			}
+			for _, ref := range networks {
+				n, err := c.FindNetwork(ctx, ref)
+				if err != nil {
+					return err
+				}
+				spec.Nics = append(spec.Nics, iaas.NetworkInterfaceSpecification{NetworkID: n.ID})
+			}
+			if len(spec.Nics) == 0 {
+				return fmt.Errorf("a load balancer needs a network, use --network")
+			}
+			links, err := targets(cmd, c, machines)
+			if err != nil {
+				return err
+			}
+			spec.TargetLinks = append(spec.TargetLinks, links...)
+			t, err := c.CreateLoadBalancer(ctx, spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "load balancer specification file")
+	cmd.Flags().StringVar(&name, "name", "", "name of the load balancer")
+	cmd.Flags().StringVar(&project, "project", "", "project name or id")
+	cmd.RegisterFlagCompletionFunc("project", cmdutil.CompleteProjects)
+	cmd.Flags().StringSliceVar(&networks, "network", nil, "network name or id to attach, repeatable")
+	cmd.Flags().StringArrayVar(&routeSpecs, "route", nil, "route protocol:port[:memberProtocol:memberPort], repeatable")
+	cmd.Flags().StringSliceVar(&machines, "target", nil, "machine to add to the pool, repeatable")
+	cmd.RegisterFlagCompletionFunc("target", cmdutil.CompleteMachines)
+	cmd.Flags().BoolVar(&internetFacing, "internet-facing", false, "give the load balancer a public address")
+	cmd.Flags().StringVar(&typ, "type", "", "provider variant, e.g. SMALL for NSX")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newScaleCommand() *cobra.Command {
+	var file string
+	var routeSpecs, machines []string
+	var wait bool
+	cmd := &cobra.Command{
+		Use:   "scale <load-balancer> [--target <machine>...] [--route <route>...]",
+		Short: "change the pool members or routes of a load balancer",
+		Long: `Scale a load balancer to a new pool: --target lists every pool member, the
+load balancer does not report its current members so the full pool is
+needed. --route replaces the routes, otherwise they are kept. -f sends a full
+specification instead.`,
+		Example:           `  iv lb scale web-lb --target web-01 --target web-02 --target web-03`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteLoadBalancers,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" && len(machines) == 0 {
+				return fmt.Errorf("give the pool members with --target, or a specification with -f")
+			}
+			r, err := parseRoutes(routeSpecs)
+			if err != nil {
+				return err
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			l, err := c.FindLoadBalancer(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			var spec *iaas.LoadBalancerSpecification
+			if file != "" {
+				spec = &iaas.LoadBalancerSpecification{}
+				err = cmdutil.LoadSpec(file, spec)
+			} else {
+				spec, err = c.ScaleSpecification(ctx, l)
+			}
+			if err != nil {
+				return err
+			}
+			if len(r) > 0 {
+				spec.Routes = r
+			}
+			if len(machines) > 0 {
+				if spec.TargetLinks, err = targets(cmd, c, machines); err != nil {
+					return err
+				}
+			}
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+			t, err := c.ScaleLoadBalancer(ctx, l.ID, *spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "full load balancer specification to scale to")
+	cmd.Flags().StringArrayVar(&routeSpecs, "route", nil, "route protocol:port[:memberProtocol:memberPort], repeatable, replaces the routes")
+	cmd.Flags().StringSliceVar(&machines, "target", nil, "pool member machine, repeatable, replaces the pool")
+	cmd.RegisterFlagCompletionFunc("target", cmdutil.CompleteMachines)
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var wait, force bool
+	cmd := &cobra.Command{
+		Use:               "delete <load-balancer>",
+		Short:             "delete a load balancer by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteLoadBalancers,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			l, err := c.FindLoadBalancer(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.DeleteLoadBalancer(cmd.Context(), l.ID, force)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "remove the load balancer from vRA even if deleting it on the provider fails")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
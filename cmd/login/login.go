@@ -8,8 +8,6 @@ import (
 
 
 func NewLoginCommand() *cobra.Command {
-	fmt.Println("Login here")
-
 	cmd := &cobra.Command {
 		Use: "iv login",
 		Short: "iv login will log into the REST server",
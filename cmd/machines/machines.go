@@ -0,0 +1,127 @@
+package machines
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+func NewMachinesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "machines",
+		Aliases: []string{"machine"},
+		Short:   "day 2 operations on machines",
+	}
+	cmd.AddCommand(newChangeSGCommand())
+	return cmd
+}
+
+func newChangeSGCommand() *cobra.Command {
+	var groups []string
+	var nic int
+	var add, remove, wait bool
+	cmd := &cobra.Command{
+		Use:   "change-sg <machine> --sg <security-group>... [--add | --remove] [--nic <index>]",
+		Short: "change the security groups of a vSphere machine's network interfaces",
+		Long: `Set the security groups of the network interfaces of a vSphere machine.
+By default the given groups replace the current ones, --add and --remove
+change them instead. --nic picks one interface by device index, otherwise
+every interface is changed. Only groups of the machine's deployment can be
+used, which is checked before the request is sent.`,
+		Example: `  iv machines change-sg web-01 --sg web --sg ssh
+  iv machines change-sg web-01 --sg debug --remove --nic 0`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if add && remove {
+				return fmt.Errorf("--add and --remove exclude each other")
+			}
+			// --sg "" is how all groups are removed on purpose
+			if !cmd.Flags().Changed("sg") {
+				return fmt.Errorf("give the security groups with --sg")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			m, err := c.FindMachine(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			var ids []string
+			for _, ref := range groups {
+				if ref == "" {
+					continue
+				}
+				g, err := c.FindSecurityGroup(ctx, ref)
+				if err != nil {
+					return err
+				}
+				if g.DeploymentID != m.DeploymentID {
+					return fmt.Errorf("security group %s is not in the deployment of machine %s", g.Name, m.Name)
+				}
+				ids = append(ids, g.ID)
+			}
+			nics, err := c.MachineNetworkInterfaces(ctx, m)
+			if err != nil {
+				return err
+			}
+			var specs []iaas.NetworkInterfaceSpecification
+			for _, n := range nics {
+				if nic >= 0 && n.DeviceIndex != nic {
+					continue
+				}
+				var sgs []string
+				switch {
+				case add:
+					sgs = n.SecurityGroupIDs
+					for _, id := range ids {
+						if !slices.Contains(sgs, id) {
+							sgs = append(sgs, id)
+						}
+					}
+				case remove:
+					for _, id := range n.SecurityGroupIDs {
+						if !slices.Contains(ids, id) {
+							sgs = append(sgs, id)
+						}
+					}
+				default:
+					sgs = ids
+				}
+				index := n.DeviceIndex
+				if sgs == nil {
+					sgs = []string{}
+				}
+				specs = append(specs, iaas.NetworkInterfaceSpecification{Name: n.Name, DeviceIndex: &index, SecurityGroupIDs: sgs})
+			}
+			if len(specs) == 0 {
+				if nic >= 0 {
+					return fmt.Errorf("machine %s has no network interface with device index %d", m.Name, nic)
+				}
+				return fmt.Errorf("machine %s has no network interfaces", m.Name)
+			}
+			for _, s := range specs {
+				fmt.Fprintf(cmd.ErrOrStderr(), "nic %d: %s\n", *s.DeviceIndex, strings.Join(s.SecurityGroupIDs, ","))
+			}
+			t, err := c.ChangeSecurityGroups(ctx, m, specs)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringSliceVar(&groups, "sg", nil, "security group name or id, repeatable")
+	cmd.RegisterFlagCompletionFunc("sg", cmdutil.CompleteSecurityGroups)
+	cmd.Flags().BoolVar(&add, "add", false, "add the groups to the current ones")
+	cmd.Flags().BoolVar(&remove, "remove", false, "remove the groups from the current ones")
+	cmd.Flags().IntVar(&nic, "nic", -1, "device index of the interface to change, all when unset")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
@@ -0,0 +1,388 @@
+package naming
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/naming"
+
+	"github.com/spf13/cobra"
+)
+
+func NewNamingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "naming",
+		Short: "manage custom naming templates and preview the names they produce",
+	}
+	cmd.AddCommand(newListCommand(), newGetCommand(), newApplyCommand(), newDeleteCommand(),
+		newForProjectCommand(), newPreviewCommand())
+	return cmd
+}
+
+var namingHeader = []string{"id", "name", "projects", "templates"}
+
+func namingRows(all []iaas.CustomNaming) [][]string {
+	var rows [][]string
+	for _, n := range all {
+		var projects, templates []string
+		for _, p := range n.Projects {
+			switch {
+			case p.DefaultOrg:
+				projects = append(projects, "(org default)")
+			case p.ProjectName != "":
+				projects = append(projects, p.ProjectName)
+			default:
+				projects = append(projects, p.ProjectID)
+			}
+		}
+		for _, t := range n.Templates {
+			templates = append(templates, t.ResourceType)
+		}
+		rows = append(rows, []string{n.ID, n.Name, strings.Join(projects, ","), strings.Join(templates, ",")})
+	}
+	return rows
+}
+
+var templateHeader = []string{"resource type", "pattern", "start", "step", "unique", "counters"}
+
+func templateRows(n *iaas.CustomNaming) [][]string {
+	var rows [][]string
+	for _, t := range n.Templates {
+		var counters []string
+		for _, c := range t.Counters {
+			counters = append(counters, fmt.Sprintf("%s=%d", c.ProjectID, c.CurrentCounter))
+		}
+		rows = append(rows, []string{t.ResourceType, t.Pattern, strconv.FormatInt(t.StartCounter, 10),
+			strconv.FormatInt(naming.Step(&t), 10), strconv.FormatBool(t.UniqueName), strings.Join(counters, ",")})
+	}
+	return rows
+}
+
+func newListCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list custom namings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := c.ListNaming(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, namingHeader, func() [][]string { return namingRows(all) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "get <naming>",
+		Short: "show the templates and counters of a custom naming by name or id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			n, err := c.FindNaming(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, n, templateHeader, func() [][]string { return templateRows(n) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newForProjectCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "for-project <project>",
+		Short:             "show the custom naming that applies to a project",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := c.FindProject(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			n, err := c.NamingForProject(cmd.Context(), p.ID)
+			if err != nil {
+				return err
+			}
+			if output != cmdutil.OutputJSON {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s (%s)\n", n.Name, n.ID)
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, n, templateHeader, func() [][]string { return templateRows(n) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newApplyCommand() *cobra.Command {
+	var file, output string
+	cmd := &cobra.Command{
+		Use:   "apply -f naming.yaml",
+		Short: "create or replace a custom naming from a YAML or JSON file",
+		Long: `Create a custom naming, or replace the one with the same name (or id):
+
+  name: linux-vms
+  projects:
+    - projectName: dev        # or projectId
+      active: true
+  templates:
+    - resourceType: COMPUTE
+      pattern: ${project.name}-${###}
+      startCounter: 1
+      incrementStep: 1
+      uniqueName: true
+
+Templates missing from the file are removed from the custom naming. Run
+iv naming preview first to see the names a pattern produces.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			spec, err := naming.Load(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+			for i, p := range spec.Projects {
+				if p.ProjectID != "" || p.ProjectName == "" {
+					continue
+				}
+				proj, err := c.FindProject(ctx, p.ProjectName)
+				if err != nil {
+					return err
+				}
+				spec.Projects[i].ProjectID = proj.ID
+			}
+			if spec.ID == "" {
+				all, err := c.ListNaming(ctx)
+				if err != nil {
+					return err
+				}
+				for _, n := range all {
+					if n.Name != spec.Name {
+						continue
+					}
+					if spec.ID != "" {
+						return fmt.Errorf("custom naming name %s is ambiguous, set id in %s", spec.Name, file)
+					}
+					spec.ID = n.ID
+				}
+			}
+			var n *iaas.CustomNaming
+			action := "created"
+			if spec.ID != "" {
+				n, err = c.UpdateNaming(ctx, spec)
+				action = "replaced"
+			} else {
+				n, err = c.CreateNaming(ctx, spec)
+			}
+			if err != nil {
+				return err
+			}
+			if n.ID == "" {
+				// the update may answer 204
+				n = spec
+			}
+			if output == cmdutil.OutputJSON {
+				return cmdutil.PrintJSON(cmd.OutOrStdout(), n)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "naming %s %s (%s)\n", n.Name, action, n.ID)
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "custom naming file")
+	cmd.MarkFlagRequired("filename")
+	cmd.MarkFlagFilename("filename", "yaml", "yml", "json")
+	cmdutil.AddOutputFlag(cmd, &output, "text", cmdutil.OutputJSON)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <naming>",
+		Short: "delete a custom naming by name or id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			n, err := c.FindNaming(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if err := c.DeleteNaming(cmd.Context(), n.ID); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "naming %s deleted (%s)\n", n.Name, n.ID)
+			return nil
+		},
+	}
+	return cmd
+}
+
+func newPreviewCommand() *cobra.Command {
+	var pattern, project, namingRef, resourceType, output string
+	var count int
+	var counter int64
+	var vars map[string]string
+	var check bool
+	cmd := &cobra.Command{
+		Use:   "preview [--template <pattern>] [--project <project>] [--count 5]",
+		Short: "render the next names of a template locally",
+		Long: `Render the names a template produces next, without deploying anything.
+
+The pattern comes from --template, or from the custom naming of --naming or
+--project for --resource-type. ${###} is the counter padded to as many digits
+as there are #, ${project.name}, ${project.id} and the project's
+${project.customProperties.<key>} are filled from --project and anything else
+from --var key=value.
+
+The counter continues from the project's current counter of the template
+(plus the increment step), starts at the template's start counter when the
+project has none, and --counter overrides both. --check marks compute names
+that are already taken by a machine.`,
+		Example: `  iv naming preview --template '${project.name}-${###}' --project dev --count 5
+  iv naming preview --project dev --resource-type COMPUTE --check`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if pattern == "" && project == "" && namingRef == "" {
+				return fmt.Errorf("set --template, --project or --naming")
+			}
+			if count < 1 {
+				return fmt.Errorf("--count must be at least 1")
+			}
+			ctx := cmd.Context()
+			var c *iaas.Client
+			if project != "" || namingRef != "" || check {
+				var err error
+				if c, err = cmdutil.NewIaaSClient(cmd); err != nil {
+					return err
+				}
+			}
+			values := map[string]string{}
+			projectID := ""
+			if project != "" {
+				p, err := c.FindProject(ctx, project)
+				if err != nil {
+					return err
+				}
+				projectID, values = p.ID, naming.ProjectVars(p)
+			}
+			for k, v := range vars {
+				values[k] = v
+			}
+
+			// the template of the naming in use gives pattern and counters
+			var t *iaas.NamingTemplate
+			var n *iaas.CustomNaming
+			var err error
+			switch {
+			case namingRef != "":
+				n, err = c.FindNaming(ctx, namingRef)
+			case project != "":
+				n, err = c.NamingForProject(ctx, projectID)
+				if pattern != "" && err != nil {
+					// a project without custom naming still previews a --template
+					n, err = nil, nil
+				}
+			}
+			if err != nil {
+				return err
+			}
+			if n != nil {
+				var ok bool
+				if t, ok = naming.Template(n, resourceType); !ok && pattern == "" {
+					return fmt.Errorf("naming %s has no %s template", n.Name, resourceType)
+				}
+			}
+			if pattern == "" {
+				pattern = t.Pattern
+			}
+			next, step := int64(1), int64(1)
+			if t != nil {
+				next, step = naming.NextCounter(t, projectID), naming.Step(t)
+			}
+			if cmd.Flags().Changed("counter") {
+				next = counter
+			}
+			names, err := naming.Preview(pattern, values, next, step, count)
+			if err != nil {
+				return fmt.Errorf("%w, set it with --var or --project", err)
+			}
+
+			type preview struct {
+				Name    string `json:"name"`
+				Counter int64  `json:"counter"`
+				Taken   *bool  `json:"taken,omitempty"`
+			}
+			rows := make([]preview, len(names))
+			for i, name := range names {
+				rows[i] = preview{Name: name, Counter: next + int64(i)*step}
+				if check {
+					machines, err := c.ListMachines(ctx, &iaas.ListOptions{Filter: "name eq " + iaas.Quote(name), Limit: 1})
+					if err != nil {
+						return err
+					}
+					taken := len(machines) > 0
+					rows[i].Taken = &taken
+				}
+			}
+			header := []string{"counter", "name"}
+			if check {
+				header = append(header, "taken")
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, rows, header, func() [][]string {
+				var out [][]string
+				for _, r := range rows {
+					row := []string{strconv.FormatInt(r.Counter, 10), r.Name}
+					if r.Taken != nil {
+						row = append(row, strconv.FormatBool(*r.Taken))
+					}
+					out = append(out, row)
+				}
+				return out
+			})
+		},
+	}
+	cmd.Flags().StringVar(&pattern, "template", "", "pattern to render, e.g. '${project.name}-${###}'")
+	cmd.Flags().StringVar(&project, "project", "", "project the names are for, gives variables, counters and its custom naming")
+	cmd.RegisterFlagCompletionFunc("project", cmdutil.CompleteProjects)
+	cmd.Flags().StringVar(&namingRef, "naming", "", "take template and counters from this custom naming")
+	cmd.Flags().StringVar(&resourceType, "resource-type", "COMPUTE", "resource type of the template")
+	cmd.Flags().IntVar(&count, "count", 5, "number of names")
+	cmd.Flags().Int64Var(&counter, "counter", 0, "counter of the first name")
+	cmd.Flags().StringToStringVar(&vars, "var", nil, "value of a template variable, e.g. --var resource.env=prod")
+	cmd.Flags().BoolVar(&check, "check", false, "mark names already used by a machine")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
@@ -0,0 +1,234 @@
+package org
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	vra8 "iv/pkg/endpoints/vra/auth"
+	"iv/pkg/endpoints/vra/iam"
+
+	"github.com/spf13/cobra"
+)
+
+func NewOrgCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "org",
+		Short: "manage the users, groups and roles of an org",
+	}
+	cmd.AddCommand(newUsersCommand(), newGroupsCommand(), newRolesCommand())
+	return cmd
+}
+
+func newUsersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "users",
+		Short: "list and search org users",
+	}
+
+	var output string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "list every user of the org with their org roles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			users, err := c.ListOrgUsers(cmd.Context(), org)
+			if err != nil {
+				return err
+			}
+			return printUsers(cmd, output, users)
+		},
+	}
+	cmdutil.AddOrgFlag(list)
+	cmdutil.AddOutputFlag(list, &output)
+
+	var searchOutput string
+	search := &cobra.Command{
+		Use:   "search <term>",
+		Short: "search org users by name, email or username",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			users, err := c.SearchOrgUsers(cmd.Context(), org, args[0])
+			if err != nil {
+				return err
+			}
+			return printUsers(cmd, searchOutput, users)
+		},
+	}
+	cmdutil.AddOrgFlag(search)
+	cmdutil.AddOutputFlag(search, &searchOutput)
+
+	cmd.AddCommand(list, search)
+	return cmd
+}
+
+func printUsers(cmd *cobra.Command, output string, users []vra8.ExpandedTypedUser) error {
+	return cmdutil.Print(cmd.OutOrStdout(), output, users,
+		[]string{"user id", "username", "email", "org roles"},
+		func() [][]string {
+			var rows [][]string
+			for _, u := range users {
+				var id, username, email string
+				if u.User != nil {
+					id, username, email = iam.Str(u.User.UserId), iam.Str(u.User.Username), iam.Str(u.User.Email)
+				}
+				var roles []string
+				if u.OrganizationRoles != nil {
+					for _, r := range *u.OrganizationRoles {
+						roles = append(roles, iam.Str(r.Name))
+					}
+				}
+				rows = append(rows, []string{id, username, email, strings.Join(roles, ",")})
+			}
+			return rows
+		})
+}
+
+func newGroupsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "groups",
+		Short: "list, search and remove org groups",
+	}
+
+	var output string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "list the groups added to the org",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			groups, err := c.ListOrgGroups(cmd.Context(), org)
+			if err != nil {
+				return err
+			}
+			return printGroups(cmd, output, groups)
+		},
+	}
+	cmdutil.AddOrgFlag(list)
+	cmdutil.AddOutputFlag(list, &output)
+
+	var searchOutput string
+	search := &cobra.Command{
+		Use:   "search <term>",
+		Short: "search the org's identity provider for groups",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			groups, err := c.SearchOrgGroups(cmd.Context(), org, args[0])
+			if err != nil {
+				return err
+			}
+			return printGroups(cmd, searchOutput, groups)
+		},
+	}
+	cmdutil.AddOrgFlag(search)
+	cmdutil.AddOutputFlag(search, &searchOutput)
+
+	remove := &cobra.Command{
+		Use:   "remove <group-id>...",
+		Short: "remove groups, and the roles they grant, from the org",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.RemoveOrgGroups(cmd.Context(), org, args)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if res.Succeeded != nil {
+				for _, id := range *res.Succeeded {
+					fmt.Fprintf(out, "removed %s\n", id)
+				}
+			}
+			if res.Failures != nil && len(*res.Failures) > 0 {
+				for _, f := range *res.Failures {
+					fmt.Fprintf(cmd.ErrOrStderr(), "failed %s: %s\n", iam.Str(f.Id), iam.Str(f.Message))
+				}
+				return fmt.Errorf("%d group(s) could not be removed", len(*res.Failures))
+			}
+			return nil
+		},
+	}
+	cmdutil.AddOrgFlag(remove)
+
+	cmd.AddCommand(list, search, remove)
+	return cmd
+}
+
+func printGroups(cmd *cobra.Command, output string, groups []vra8.ExpandedGroup) error {
+	return cmdutil.Print(cmd.OutOrStdout(), output, groups,
+		[]string{"group id", "name", "domain", "users", "org roles"},
+		func() [][]string {
+			var rows [][]string
+			for _, g := range groups {
+				var roles []string
+				if g.OrganizationRoles != nil {
+					for _, r := range *g.OrganizationRoles {
+						roles = append(roles, iam.Str(r.Name))
+					}
+				}
+				users := ""
+				if g.UsersCount != nil {
+					users = strconv.Itoa(int(*g.UsersCount))
+				}
+				rows = append(rows, []string{iam.Str(g.Id), iam.Str(g.DisplayName), iam.Str(g.Domain), users, strings.Join(roles, ",")})
+			}
+			return rows
+		})
+}
+
+func newRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "inspect the roles defined in the org",
+	}
+
+	var output string
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "list the org roles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			roles, err := c.ListOrgRoles(cmd.Context(), org)
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, roles,
+				[]string{"name", "display name", "id"},
+				func() [][]string {
+					var rows [][]string
+					for _, r := range roles {
+						rows = append(rows, []string{iam.Str(r.Name), iam.Str(r.DisplayName), iam.Str(r.Id)})
+					}
+					return rows
+				})
+		},
+	}
+	cmdutil.AddOrgFlag(list)
+	cmdutil.AddOutputFlag(list, &output)
+
+	cmd.AddCommand(list)
+	return cmd
+}
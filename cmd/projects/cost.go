@@ -0,0 +1,94 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/projects"
+
+	"github.com/spf13/cobra"
+)
+
+func newCostCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cost",
+		Short: "show and set the cost of projects",
+	}
+	cmd.AddCommand(newCostGetCommand(), newCostSetCommand())
+	return cmd
+}
+
+var costHeader = []string{"project", "cost", "unit", "synced", "message"}
+
+func costRows(p *projects.Project) [][]string {
+	c := p.Cost
+	if c == nil {
+		return [][]string{{p.Name, "", "", "", ""}}
+	}
+	return [][]string{{p.Name, fmt.Sprint(c.Cost), c.CostUnit, c.CostSyncTime, c.Message}}
+}
+
+func newCostGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <project>",
+		Short:             "show the cost of a project",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewProjectsClient(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := c.FindProject(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, p.Cost, costHeader, func() [][]string { return costRows(p) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newCostSetCommand() *cobra.Command {
+	var cost float64
+	var unit, syncTime, output string
+	cmd := &cobra.Command{
+		Use:               "set <project> --cost <amount> [--unit EUR]",
+		Short:             "set the cost of a project",
+		Example:           `  iv projects cost set dev --cost 1250.50 --unit EUR`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("cost") {
+				return errors.New("give the cost with --cost")
+			}
+			if cost < 0 {
+				return fmt.Errorf("cost %v is negative", cost)
+			}
+			if unit != "" && len(unit) != 3 {
+				return fmt.Errorf("unit %q is not a 3 letter currency code", unit)
+			}
+			c, err := cmdutil.NewProjectsClient(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := c.FindProject(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if p, err = c.UpdateCost(cmd.Context(), p.ID, projects.Cost{Cost: cost, CostUnit: strings.ToUpper(unit), CostSyncTime: syncTime}); err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, p.Cost, costHeader, func() [][]string { return costRows(p) })
+		},
+	}
+	cmd.Flags().Float64Var(&cost, "cost", 0, "cost of the project")
+	cmd.Flags().StringVar(&unit, "unit", "", "3 letter currency code, e.g. EUR")
+	cmd.Flags().StringVar(&syncTime, "sync-time", "", "date the cost is calculated as of, YYYY-MM-DDThh:mm:ssZ")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
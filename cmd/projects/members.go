@@ -0,0 +1,147 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/projects"
+
+	"github.com/spf13/cobra"
+)
+
+func newMembersCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members",
+		Short: "list and change the users and groups of projects",
+		Long: `List and change the users and groups of projects. Principals are user names
+(emails); group:<name> is a group. The roles are administrator, member,
+viewer and supervisor.`,
+	}
+	cmd.AddCommand(newMembersListCommand(), newMembersAddCommand(), newMembersRemoveCommand())
+	return cmd
+}
+
+var memberHeader = []string{"principal", "type", "role"}
+
+func memberRows(all []projects.PrincipalRole) [][]string {
+	var rows [][]string
+	for _, p := range all {
+		rows = append(rows, []string{p.Email, principalType(p), p.Role})
+	}
+	return rows
+}
+
+func principalType(p projects.PrincipalRole) string {
+	if p.Type == "" {
+		return projects.TypeUser
+	}
+	return p.Type
+}
+
+// parsePrincipal reads a user name, or group:<name> for a group
+func parsePrincipal(s, role string) (projects.PrincipalRole, error) {
+	p := projects.PrincipalRole{Email: s, Type: projects.TypeUser, Role: role}
+	if name, ok := strings.CutPrefix(s, projects.TypeGroup+":"); ok {
+		p.Email, p.Type = name, projects.TypeGroup
+	}
+	if p.Email == "" {
+		return p, fmt.Errorf("empty principal %q", s)
+	}
+	return p, nil
+}
+
+func parsePrincipals(args []string, role string) ([]projects.PrincipalRole, error) {
+	var out []projects.PrincipalRole
+	for _, a := range args {
+		p, err := parsePrincipal(a, role)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, p)
+	}
+	return out, nil
+}
+
+func newMembersListCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "list <project>",
+		Short:             "list the principals of a project and their roles",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewProjectsClient(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := c.FindProject(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			all := p.Principals()
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, memberHeader, func() [][]string { return memberRows(all) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newMembersAddCommand() *cobra.Command {
+	var role string
+	cmd := &cobra.Command{
+		Use:   "add <project> <principal>... [--role member]",
+		Short: "add principals to a project or change their role",
+		Example: `  iv projects members add dev alice@corp.example --role administrator
+  iv projects members add dev group:dev-team@corp.example`,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			r, err := projects.ParseRole(role)
+			if err != nil {
+				return err
+			}
+			add, err := parsePrincipals(args[1:], r)
+			if err != nil {
+				return err
+			}
+			return modify(cmd, args[0], projects.PrincipalsAssignment{Modify: add})
+		},
+	}
+	cmd.Flags().StringVar(&role, "role", projects.RoleMember, "role of the principals: "+strings.Join(projects.Roles, ", "))
+	cmd.RegisterFlagCompletionFunc("role", cobra.FixedCompletions(projects.Roles, cobra.ShellCompDirectiveNoFileComp))
+	return cmd
+}
+
+func newMembersRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "remove <project> <principal>...",
+		Short:             "remove principals from a project",
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			remove, err := parsePrincipals(args[1:], "")
+			if err != nil {
+				return err
+			}
+			return modify(cmd, args[0], projects.PrincipalsAssignment{Remove: remove})
+		},
+	}
+	return cmd
+}
+
+// modify applies a to a project and lists the resulting principals
+func modify(cmd *cobra.Command, ref string, a projects.PrincipalsAssignment) error {
+	c, err := cmdutil.NewProjectsClient(cmd)
+	if err != nil {
+		return err
+	}
+	p, err := c.FindProject(cmd.Context(), ref)
+	if err != nil {
+		return err
+	}
+	if p, err = c.ModifyPrincipals(cmd.Context(), p.ID, a); err != nil {
+		return err
+	}
+	return cmdutil.PrintTable(cmd.OutOrStdout(), memberHeader, memberRows(p.Principals()))
+}
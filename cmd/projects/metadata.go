@@ -0,0 +1,206 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/endpoints/vra/projects"
+
+	"github.com/spf13/cobra"
+)
+
+func newMetadataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata",
+		Short: "show and change the tags applied to every resource of a project",
+		Long: `Show and change the resource metadata of projects: tags the Project Service
+applies to every compute resource provisioned in the project. Tags are
+key=value or key:value.`,
+	}
+	cmd.AddCommand(newMetadataGetCommand(), newMetadataSetCommand(), newMetadataMergeCommand())
+	return cmd
+}
+
+var metadataHeader = []string{"project", "tags"}
+
+// metadataResult is the resource metadata of one project after a change
+type metadataResult struct {
+	Project string         `json:"project"`
+	Tags    []projects.Tag `json:"tags"`
+	Changed bool           `json:"changed"`
+}
+
+func metadataRows(all []metadataResult) [][]string {
+	var rows [][]string
+	for _, r := range all {
+		var tags []string
+		for _, t := range r.Tags {
+			tags = append(tags, t.Key+":"+t.Value)
+		}
+		rows = append(rows, []string{r.Project, strings.Join(tags, ",")})
+	}
+	return rows
+}
+
+func toIaaSTags(tags []projects.Tag) []iaas.Tag {
+	out := make([]iaas.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, iaas.Tag{Key: t.Key, Value: t.Value})
+	}
+	return out
+}
+
+func fromIaaSTags(tags []iaas.Tag) []projects.Tag {
+	out := make([]projects.Tag, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, projects.Tag{Key: t.Key, Value: t.Value})
+	}
+	return out
+}
+
+func newMetadataGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <project>",
+		Short:             "show the resource tags of a project",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewProjectsClient(cmd)
+			if err != nil {
+				return err
+			}
+			p, err := c.FindProject(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			m, err := c.GetResourceMetadata(cmd.Context(), p.ID)
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, m, metadataHeader, func() [][]string {
+				return metadataRows([]metadataResult{{Project: p.Name, Tags: m.Tags}})
+			})
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// changeMetadata applies change to the resource tags of the projects named by
+// args, or of every project with --all, and only updates the projects whose
+// tags change
+func changeMetadata(cmd *cobra.Command, all bool, args []string, output string, change func([]projects.Tag, []string) ([]projects.Tag, bool, error)) error {
+	tagArgs := args
+	if !all {
+		if len(args) < 2 {
+			return errors.New("give a project and the tags, or --all and the tags")
+		}
+		tagArgs = args[1:]
+	} else if len(args) == 0 {
+		return errors.New("give the tags")
+	}
+	// check the arguments before anything is read
+	if _, _, err := change(nil, tagArgs); err != nil {
+		return err
+	}
+	c, err := cmdutil.NewProjectsClient(cmd)
+	if err != nil {
+		return err
+	}
+	var targets []projects.Project
+	if all {
+		if targets, err = c.ListProjects(cmd.Context()); err != nil {
+			return err
+		}
+	} else {
+		p, err := c.FindProject(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		targets = append(targets, *p)
+	}
+	var results []metadataResult
+	var errs []error
+	for _, p := range targets {
+		m, err := c.GetResourceMetadata(cmd.Context(), p.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+			continue
+		}
+		tags, changed, _ := change(m.Tags, tagArgs)
+		if changed {
+			if m, err = c.UpdateResourceMetadata(cmd.Context(), p.ID, projects.ResourceMetadata{Tags: tags}); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+				continue
+			}
+			tags = m.Tags
+		}
+		results = append(results, metadataResult{Project: p.Name, Tags: tags, Changed: changed})
+	}
+	if err := cmdutil.Print(cmd.OutOrStdout(), output, results, metadataHeader, func() [][]string { return metadataRows(results) }); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+func newMetadataSetCommand() *cobra.Command {
+	var all bool
+	var output string
+	cmd := &cobra.Command{
+		Use:   "set (<project> | --all) <key=value | key->...",
+		Short: "set or remove resource tags of projects",
+		Long: `Change the resource tags of a project, or of every project with --all:
+key=value sets a tag, replacing the values the key had, and key- removes the
+key. Other tags are kept.`,
+		Example:           `  iv projects metadata set dev costcenter=4711 legacy-`,
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return changeMetadata(cmd, all, args, output, func(current []projects.Tag, args []string) ([]projects.Tag, bool, error) {
+				set, remove, err := iaas.ParseTagChanges(args)
+				if err != nil {
+					return nil, false, err
+				}
+				tags, changed := iaas.MergeTags(toIaaSTags(current), set, remove)
+				return fromIaaSTags(tags), changed, nil
+			})
+		},
+	}
+	cmd.Flags().BoolVar(&all, "all", false, "change every project")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newMetadataMergeCommand() *cobra.Command {
+	var all bool
+	var output string
+	cmd := &cobra.Command{
+		Use:   "merge (<project> | --all) <key=value>...",
+		Short: "add resource tags to projects, keeping the existing ones",
+		Long: `Add tags to the resource tags of a project, or of every project with --all.
+Existing tags are never changed or removed, even when they have the same key;
+projects that already carry all the tags are not updated.`,
+		Example:           `  iv projects metadata merge --all costcenter=4711`,
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return changeMetadata(cmd, all, args, output, func(current []projects.Tag, args []string) ([]projects.Tag, bool, error) {
+				var add []projects.Tag
+				for _, a := range args {
+					t, err := iaas.ParseTag(a)
+					if err != nil {
+						return nil, false, err
+					}
+					add = append(add, projects.Tag{Key: t.Key, Value: t.Value})
+				}
+				tags, changed := projects.UnionTags(current, add)
+				return tags, changed, nil
+			})
+		},
+	}
+	cmd.Flags().BoolVar(&all, "all", false, "change every project")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
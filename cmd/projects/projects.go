@@ -0,0 +1,15 @@
+package projects
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func NewProjectsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "projects",
+		Aliases: []string{"project"},
+		Short:   "manage project membership, cost and resource tags through the Project Service",
+	}
+	cmd.AddCommand(newMembersCommand(), newSyncPrincipalsCommand(), newCostCommand(), newMetadataCommand())
+	return cmd
+}
@@ -0,0 +1,228 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/projects"
+
+	"github.com/spf13/cobra"
+)
+
+func newSyncPrincipalsCommand() *cobra.Command {
+	var all, yes, dryRun bool
+	var file, output string
+	cmd := &cobra.Command{
+		Use:   "sync-principals (<project>... | --all | --from-file <file>)",
+		Short: "refresh project principals from the identity provider, or reconcile them with a file",
+		Long: `Without --from-file, make the Project Service pick up changes of users and
+groups in the identity provider, e.g. a renamed group, for the given projects
+or for all of them.
+
+With --from-file, make the principals of the projects in a YAML or JSON file
+match it exactly: principals missing from a project are added, roles are
+changed, and principals the file does not list are removed. Projects not in
+the file are left alone. The changes are listed first and applied after
+confirmation; --yes skips the question and --dry-run only lists them.
+
+  projects:
+  - project: dev
+    administrators: [alice@corp.example]
+    members: [group:dev-team@corp.example]
+    viewers: [group:auditors@corp.example]`,
+		Example: `  iv projects sync-principals --all
+  iv projects sync-principals --from-file teams.yaml --dry-run`,
+		ValidArgsFunction: cmdutil.CompleteProjects,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file != "" {
+				if all || len(args) > 0 {
+					return errors.New("--from-file names the projects itself, drop the projects and --all")
+				}
+				return reconcile(cmd, file, output, yes, dryRun)
+			}
+			if yes || dryRun {
+				return errors.New("--yes and --dry-run only apply to --from-file")
+			}
+			if all == (len(args) > 0) {
+				return errors.New("give the projects, --all or --from-file")
+			}
+			return refresh(cmd, all, args)
+		},
+	}
+	cmd.Flags().BoolVar(&all, "all", false, "refresh every project")
+	cmd.Flags().StringVar(&file, "from-file", "", "YAML or JSON file with the desired membership of projects")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "apply --from-file without asking")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only list the changes --from-file makes")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// refresh asks the Project Service to sync the principals of projects with
+// the identity provider
+func refresh(cmd *cobra.Command, all bool, refs []string) error {
+	c, err := cmdutil.NewProjectsClient(cmd)
+	if err != nil {
+		return err
+	}
+	var ids, names []string
+	if all {
+		found, err := c.ListProjects(cmd.Context())
+		if err != nil {
+			return err
+		}
+		for _, p := range found {
+			ids, names = append(ids, p.ID), append(names, p.Name)
+		}
+	} else {
+		for _, ref := range refs {
+			p, err := c.FindProject(cmd.Context(), ref)
+			if err != nil {
+				return err
+			}
+			ids, names = append(ids, p.ID), append(names, p.Name)
+		}
+	}
+	var errs []error
+	for i, id := range ids {
+		if err := c.SyncPrincipals(cmd.Context(), id); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", names[i], err))
+			continue
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "%s: synced\n", names[i])
+	}
+	return errors.Join(errs...)
+}
+
+// membership is the desired principals of a project in a --from-file
+type membership struct {
+	Project        string   `json:"project"`
+	Administrators []string `json:"administrators"`
+	Members        []string `json:"members"`
+	Viewers        []string `json:"viewers"`
+	Supervisors    []string `json:"supervisors"`
+}
+
+type membershipFile struct {
+	Projects []membership `json:"projects"`
+}
+
+func (m membership) principals() ([]projects.PrincipalRole, error) {
+	var out []projects.PrincipalRole
+	for _, r := range []struct {
+		role  string
+		names []string
+	}{
+		{projects.RoleAdministrator, m.Administrators},
+		{projects.RoleMember, m.Members},
+		{projects.RoleViewer, m.Viewers},
+		{projects.RoleSupervisor, m.Supervisors},
+	} {
+		p, err := parsePrincipals(r.names, r.role)
+		if err != nil {
+			return nil, fmt.Errorf("project %s: %w", m.Project, err)
+		}
+		out = append(out, p...)
+	}
+	return out, nil
+}
+
+// change is one line of the reconcile diff
+type change struct {
+	Project   string `json:"project"`
+	Action    string `json:"action"`
+	Principal string `json:"principal"`
+	Type      string `json:"type"`
+	Role      string `json:"role"`
+	Was       string `json:"was,omitempty"`
+}
+
+// plan is the assignment for one project of a --from-file
+type plan struct {
+	project *projects.Project
+	assign  projects.PrincipalsAssignment
+}
+
+func (p plan) changes() []change {
+	was := map[string]string{}
+	for _, pr := range p.project.Principals() {
+		if _, ok := was[pr.Key()]; !ok {
+			was[pr.Key()] = pr.Role
+		}
+	}
+	var out []change
+	for _, pr := range p.assign.Modify {
+		ch := change{Project: p.project.Name, Action: "add", Principal: pr.Email, Type: principalType(pr), Role: pr.Role}
+		if old, ok := was[pr.Key()]; ok {
+			ch.Action, ch.Was = "change", old
+		}
+		out = append(out, ch)
+	}
+	for _, pr := range p.assign.Remove {
+		out = append(out, change{Project: p.project.Name, Action: "remove", Principal: pr.Email, Type: principalType(pr), Role: pr.Role})
+	}
+	return out
+}
+
+// reconcile makes the membership of the projects in file match it, printing
+// the changes before anything is applied
+func reconcile(cmd *cobra.Command, file, output string, yes, dryRun bool) error {
+	var want membershipFile
+	if err := cmdutil.LoadSpec(file, &want); err != nil {
+		return err
+	}
+	c, err := cmdutil.NewProjectsClient(cmd)
+	if err != nil {
+		return err
+	}
+	var plans []plan
+	var changes []change
+	seen := map[string]bool{}
+	for _, m := range want.Projects {
+		principals, err := m.principals()
+		if err != nil {
+			return err
+		}
+		p, err := c.FindProject(cmd.Context(), m.Project)
+		if err != nil {
+			return err
+		}
+		if seen[p.ID] {
+			return fmt.Errorf("project %s is listed twice", p.Name)
+		}
+		seen[p.ID] = true
+		pl := plan{project: p, assign: p.Reconcile(principals)}
+		if pl.assign.Empty() {
+			continue
+		}
+		plans = append(plans, pl)
+		changes = append(changes, pl.changes()...)
+	}
+	err = cmdutil.Print(cmd.OutOrStdout(), output, changes, []string{"project", "action", "principal", "type", "role", "was"}, func() [][]string {
+		var rows [][]string
+		for _, ch := range changes {
+			rows = append(rows, []string{ch.Project, ch.Action, ch.Principal, ch.Type, ch.Role, ch.Was})
+		}
+		return rows
+	})
+	if err != nil || len(plans) == 0 || dryRun {
+		return err
+	}
+	if !yes {
+		p := cmdutil.NewPrompter(cmd)
+		if !p.Interactive() {
+			return errors.New("not a terminal, use --yes to apply without confirmation")
+		}
+		ok, err := p.Confirm(fmt.Sprintf("apply %d changes to %d projects", len(changes), len(plans)))
+		if err != nil || !ok {
+			return err
+		}
+	}
+	var errs []error
+	for _, pl := range plans {
+		if _, err := c.ModifyPrincipals(cmd.Context(), pl.project.ID, pl.assign); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", pl.project.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
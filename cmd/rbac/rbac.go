@@ -0,0 +1,88 @@
+package rbac
+
+import (
+	"fmt"
+	"os"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/rbac"
+
+	"github.com/spf13/cobra"
+)
+
+func NewRBACCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rbac",
+		Short: "manage org and service role assignments declaratively",
+	}
+	cmd.AddCommand(newApplyCommand())
+	return cmd
+}
+
+func newApplyCommand() *cobra.Command {
+	var file, output string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "apply -f roles.yaml",
+		Short: "reconcile user and group roles with a YAML file",
+		Long: `Reconcile user and group roles with a YAML file:
+
+  org: <org-id>
+  users:
+    - user: alice@corp.local
+      orgRoles: [org_member]
+      serviceRoles:
+        <service-definition-id>: [automationservice:cloud_admin]
+  groups:
+    - group: <group-id>
+      orgRoles: [org_member]
+
+Only declared subjects, and for them only orgRoles (when present) and the
+listed services, are changed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			spec, err := rbac.Load(f)
+			f.Close()
+			if err != nil {
+				return fmt.Errorf("%s: %w", file, err)
+			}
+			if spec.Org != "" && !cmd.Flags().Changed(cmdutil.OrgFlag) {
+				cmd.Flags().Set(cmdutil.OrgFlag, spec.Org)
+			}
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			plan, err := rbac.Diff(cmd.Context(), c, org, spec)
+			if err != nil {
+				return err
+			}
+			out := cmd.OutOrStdout()
+			if output == cmdutil.OutputJSON {
+				if err := cmdutil.PrintJSON(out, plan); err != nil {
+					return err
+				}
+			} else {
+				plan.Print(out)
+			}
+			if dryRun || plan.Empty() {
+				return nil
+			}
+			if err := plan.Apply(cmd.Context(), c); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "applied %d change(s)\n", len(plan.Changes))
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "desired roles YAML file")
+	cmd.MarkFlagRequired("filename")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "print the diff without changing anything")
+	cmd.Flags().StringVarP(&output, "output", "o", "text", "diff format: text|json")
+	cmdutil.AddOrgFlag(cmd)
+	return cmd
+}
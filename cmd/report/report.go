@@ -0,0 +1,133 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/report"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	outputCSV   = "csv"
+	outputExcel = "xlsx-csv"
+	outputJSON  = cmdutil.OutputJSON
+	outputTable = cmdutil.OutputTable
+)
+
+func NewReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "export reports for audits and planning",
+	}
+	cmd.AddCommand(newAccessCommand(), newCapacityCommand())
+	return cmd
+}
+
+func newAccessCommand() *cobra.Command {
+	var output, file string
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "who has which role in the org, one row per user and role",
+		Long: `Lists every org and service role of every org user together with the source
+of the grant: direct, group, or nested-group with the group path in "via".`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			rows, err := report.Access(cmd.Context(), c, org)
+			if err != nil {
+				return err
+			}
+			return write(cmd, output, file, rows, report.AccessHeader, func() [][]string {
+				out := make([][]string, 0, len(rows))
+				for _, r := range rows {
+					out = append(out, r.Strings())
+				}
+				return out
+			})
+		},
+	}
+	cmdutil.AddOrgFlag(cmd)
+	cmdutil.AddOutputFlag(cmd, &output, outputCSV, outputExcel, outputJSON, outputTable)
+	cmd.Flags().StringVar(&file, "file", "", "write the report to this file instead of stdout")
+	return cmd
+}
+
+func newCapacityCommand() *cobra.Command {
+	var output, file, filter string
+	var zones []string
+	cmd := &cobra.Command{
+		Use:   "capacity [--zone <name>]... [--filter ...]",
+		Short: "CPU, memory and storage of every zone against what is allocated",
+		Long: `Shows per zone the CPU, memory and storage of its fabric computes against the
+allocation of the machines on them, one row per compute plus a zone total.
+CPU and memory come from the cpuCount and memoryInMB custom properties and
+respect the maximum allocation percent of a compute; storage is the free space
+of the vSphere datastores of the zone's region against the block devices in
+the zone. --zone limits the report to the named zones.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			capacity, err := report.Capacity(cmd.Context(), c, zoneFilter(zones, filter))
+			if err != nil {
+				return err
+			}
+			return write(cmd, output, file, capacity, report.CapacityHeader, func() [][]string { return report.CapacityRows(capacity) })
+		},
+	}
+	cmd.Flags().StringArrayVar(&zones, "zone", nil, "only report this zone, repeatable")
+	cmd.RegisterFlagCompletionFunc("zone", cmdutil.CompleteZones)
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter on the zones, e.g. \"name eq 'prod'\"")
+	cmdutil.AddOutputFlag(cmd, &output, outputTable, outputJSON, outputCSV, outputExcel)
+	cmd.Flags().StringVar(&file, "file", "", "write the report to this file instead of stdout")
+	return cmd
+}
+
+// zoneFilter narrows an OData zone filter to the named zones
+func zoneFilter(zones []string, filter string) string {
+	if len(zones) == 0 {
+		return filter
+	}
+	var names []string
+	for _, z := range zones {
+		names = append(names, "name eq "+iaas.Quote(z))
+	}
+	f := "(" + strings.Join(names, " or ") + ")"
+	if filter != "" {
+		f += " and (" + filter + ")"
+	}
+	return f
+}
+
+// write renders a report in the chosen format to --file or stdout
+func write(cmd *cobra.Command, format, file string, v any, header []string, rows func() [][]string) error {
+	var w io.Writer = cmd.OutOrStdout()
+	if file != "" {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	switch format {
+	case outputCSV:
+		return report.WriteCSV(w, header, rows(), false)
+	case outputExcel:
+		return report.WriteCSV(w, header, rows(), true)
+	case outputJSON, outputTable:
+		return cmdutil.Print(w, format, v, header, rows)
+	}
+	return fmt.Errorf("unknown output format %q", format)
+}
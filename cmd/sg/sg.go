@@ -0,0 +1,302 @@
+package sg
+
+import (
+	"fmt"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/spf13/cobra"
+)
+
+func NewSGCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sg",
+		Aliases: []string{"security-groups"},
+		Short:   "create security groups and change their rules",
+	}
+	cmd.AddCommand(newListCommand(), newGetCommand(), newCreateCommand(), newUpdateCommand(), newReconfigureCommand())
+	return cmd
+}
+
+var sgHeader = []string{"id", "name", "project", "rules", "status"}
+
+func sgRows(all []iaas.SecurityGroup) [][]string {
+	var rows [][]string
+	for _, g := range all {
+		rows = append(rows, []string{g.ID, g.Name, g.ProjectID, fmt.Sprint(len(g.Rules)), g.ProvisioningStatus})
+	}
+	return rows
+}
+
+var ruleHeader = []string{"name", "direction", "access", "protocol", "ports", "cidr"}
+
+func ruleRows(rules []iaas.Rule) [][]string {
+	var rows [][]string
+	for _, r := range rules {
+		proto := r.Protocol
+		if proto == "" {
+			proto = r.Service
+		}
+		rows = append(rows, []string{r.Name, r.Direction, r.Access, proto, r.Ports, r.IPRangeCidr})
+	}
+	return rows
+}
+
+// parseRules reads --rule values and validates them
+func parseRules(specs []string) ([]iaas.Rule, error) {
+	var rules []iaas.Rule
+	for _, s := range specs {
+		r, err := iaas.ParseRule(s)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r)
+	}
+	return rules, iaas.ValidateRules(rules)
+}
+
+const ruleUsage = "rule direction:access:protocol:ports:cidr, e.g. inbound:allow:tcp:443:10.0.0.0/8, repeatable"
+
+func newListCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "list [--filter ...]",
+		Short: "list security groups",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			all, err := c.ListSecurityGroups(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, sgHeader, func() [][]string { return sgRows(all) })
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"projectId eq '<id>'\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "get <security-group>",
+		Short:             "show the rules of a security group by name or id",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteSecurityGroups,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			g, err := c.FindSecurityGroup(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, g, ruleHeader, func() [][]string { return ruleRows(g.Rules) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newCreateCommand() *cobra.Command {
+	var file, name, project, deployment string
+	var ruleSpecs []string
+	var wait bool
+	cmd := &cobra.Command{
+		Use:   "create (-f <spec> | --name <name> --project <project> --rule <rule>...)",
+		Short: "create a security group",
+		Long: `Create a security group from a specification file (YAML or JSON with the
+fields of the API) or from flags; --rule adds to the rules of the file. Rules
+are checked before anything is sent: ports are ANY or a list of ports and
+ranges, ipRangeCidr is ANY or a list of CIDRs.`,
+		Example: `  iv sg create --name web --project dev --rule inbound:allow:tcp:443:0.0.0.0/0 --rule inbound:allow:ssh:22:10.0.0.0/8`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var spec iaas.SecurityGroupSpecification
+			if file != "" {
+				if err := cmdutil.LoadSpec(file, &spec); err != nil {
+					return err
+				}
+			}
+			if name != "" {
+				spec.Name = name
+			}
+			if deployment != "" {
+				spec.DeploymentID = deployment
+			}
+			rules, err := parseRules(ruleSpecs)
+			if err != nil {
+				return err
+			}
+			spec.Rules = append(spec.Rules, rules...)
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+			if spec.Name == "" {
+				return fmt.Errorf("a security group needs a name")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			if project != "" {
+				p, err := c.FindProject(cmd.Context(), project)
+				if err != nil {
+					return err
+				}
+				spec.ProjectID = p.ID
+			}
+			if spec.ProjectID == "" {
+				return fmt.Errorf("a security group needs a project, use --project")
+			}
+			t, err := c.CreateSecurityGroup(cmd.Context(), spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "security group specification file")
+	cmd.Flags().StringVar(&name, "name", "", "name of the security group")
+	cmd.Flags().StringVar(&project, "project", "", "project name or id")
+	cmd.RegisterFlagCompletionFunc("project", cmdutil.CompleteProjects)
+	cmd.Flags().StringVar(&deployment, "deployment", "", "id of the deployment the group belongs to")
+	cmd.Flags().StringArrayVar(&ruleSpecs, "rule", nil, ruleUsage)
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newUpdateCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "update <security-group> <key=value | key->...",
+		Short:             "set or remove tags of a security group",
+		Long:              `Change the tags of a security group: key=value sets a tag, replacing the values the key had, and key- removes the key. Other tags are kept.`,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: cmdutil.CompleteSecurityGroups,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			set, remove, err := iaas.ParseTagChanges(args[1:])
+			if err != nil {
+				return err
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			g, err := c.FindSecurityGroup(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			tags, _ := iaas.MergeTags(g.Tags, set, remove)
+			if tags == nil {
+				tags = []iaas.Tag{}
+			}
+			if g, err = c.UpdateSecurityGroup(cmd.Context(), g.ID, iaas.UpdateSecurityGroupSpecification{Tags: tags}); err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, g, sgHeader, func() [][]string { return sgRows([]iaas.SecurityGroup{*g}) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newReconfigureCommand() *cobra.Command {
+	var file string
+	var ruleSpecs, addSpecs, removeNames []string
+	var wait bool
+	cmd := &cobra.Command{
+		Use:   "reconfigure <security-group> (--rule <rule>... | --add-rule <rule>... | --remove-rule <name>... | -f <spec>)",
+		Short: "change the rules of a security group",
+		Long: `Reconfigure the firewall rules of a security group. --rule replaces all
+rules, --add-rule and --remove-rule (by rule name) change the current ones,
+and -f sends a full specification. The resulting rules are checked before
+anything is sent.`,
+		Example:           `  iv sg reconfigure web --add-rule inbound:allow:tcp:8443:10.0.0.0/8 --remove-rule old-http`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteSecurityGroups,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" && len(ruleSpecs)+len(addSpecs)+len(removeNames) == 0 {
+				return fmt.Errorf("nothing to change, use --rule, --add-rule, --remove-rule or -f")
+			}
+			replace, err := parseRules(ruleSpecs)
+			if err != nil {
+				return err
+			}
+			add, err := parseRules(addSpecs)
+			if err != nil {
+				return err
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			g, err := c.FindSecurityGroup(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			spec := iaas.SecurityGroupSpecification{
+				Name: g.Name, ProjectID: g.ProjectID, Description: g.Description, DeploymentID: g.DeploymentID,
+				Rules: g.Rules, CustomProperties: g.CustomProperties, Tags: g.Tags,
+			}
+			if file != "" {
+				spec = iaas.SecurityGroupSpecification{}
+				if err := cmdutil.LoadSpec(file, &spec); err != nil {
+					return err
+				}
+			}
+			if len(ruleSpecs) > 0 {
+				spec.Rules = replace
+			}
+			rules, err := removeRules(spec.Rules, removeNames)
+			if err != nil {
+				return err
+			}
+			spec.Rules = append(rules, add...)
+			if err := spec.Validate(); err != nil {
+				return err
+			}
+			t, err := c.ReconfigureSecurityGroup(cmd.Context(), g.ID, spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "full security group specification")
+	cmd.Flags().StringArrayVar(&ruleSpecs, "rule", nil, ruleUsage+", replaces all rules")
+	cmd.Flags().StringArrayVar(&addSpecs, "add-rule", nil, "rule to add, same form as --rule")
+	cmd.Flags().StringSliceVar(&removeNames, "remove-rule", nil, "name of a rule to remove, repeatable")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+// removeRules drops the rules with the given names, an unknown name is an
+// error so a typo does not pass silently
+func removeRules(rules []iaas.Rule, names []string) ([]iaas.Rule, error) {
+	drop := map[string]bool{}
+	for _, n := range names {
+		drop[n] = true
+	}
+	found := map[string]bool{}
+	var out []iaas.Rule
+	for _, r := range rules {
+		if drop[r.Name] {
+			found[r.Name] = true
+			continue
+		}
+		out = append(out, r)
+	}
+	for _, n := range names {
+		if !found[n] {
+			return nil, fmt.Errorf("security group has no rule named %q", n)
+		}
+	}
+	return out, nil
+}
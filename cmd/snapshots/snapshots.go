@@ -0,0 +1,211 @@
+package snapshots
+
+import (
+	"fmt"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/snapshots"
+
+	"github.com/spf13/cobra"
+)
+
+func NewSnapshotsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "snapshots",
+		Aliases: []string{"snapshot"},
+		Short:   "take, list, revert and prune machine snapshots",
+	}
+	cmd.AddCommand(newCreateCommand(), newListCommand(), newDeleteCommand(), newRevertCommand(), newPruneCommand())
+	return cmd
+}
+
+func newCreateCommand() *cobra.Command {
+	var spec iaas.SnapshotSpecification
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "create <machine>",
+		Short:             "take a snapshot of a machine",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			m, err := c.FindMachine(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.CreateMachineSnapshot(cmd.Context(), m.ID, spec)
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmd.Flags().StringVar(&spec.Name, "name", "", "snapshot name")
+	cmd.Flags().StringVar(&spec.Description, "description", "", "snapshot description")
+	cmd.Flags().BoolVar(&spec.SnapshotMemory, "memory", false, "include the memory of a running machine")
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newListCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:               "list <machine>",
+		Short:             "list the snapshots of a machine",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			m, err := c.FindMachine(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			snaps, err := c.ListMachineSnapshots(cmd.Context(), m.ID)
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, snaps, []string{"id", "name", "created", "current"}, func() [][]string {
+				var rows [][]string
+				for _, s := range snaps {
+					rows = append(rows, []string{s.ID, s.Name, s.CreatedAt, fmt.Sprint(s.IsCurrent)})
+				}
+				return rows
+			})
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "delete <machine> <snapshot-id>",
+		Short:             "delete a machine snapshot",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			m, err := c.FindMachine(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.DeleteMachineSnapshot(cmd.Context(), m.ID, args[1])
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newRevertCommand() *cobra.Command {
+	var wait bool
+	cmd := &cobra.Command{
+		Use:               "revert <machine> <snapshot-id>",
+		Short:             "revert a machine to a snapshot",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: cmdutil.CompleteMachines,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			m, err := c.FindMachine(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			t, err := c.RevertMachineSnapshot(cmd.Context(), m.ID, args[1])
+			if err != nil {
+				return err
+			}
+			return cmdutil.FinishRequest(cmd, c, t, wait)
+		},
+	}
+	cmdutil.AddWaitFlag(cmd, &wait)
+	return cmd
+}
+
+func newPruneCommand() *cobra.Command {
+	var (
+		filter, olderThan, output string
+		keepLast, concurrency     int
+		dryRun                    bool
+	)
+	cmd := &cobra.Command{
+		Use:   "prune [--keep-last N] [--older-than 7d] [--filter ...]",
+		Short: "delete old snapshots across machines",
+		Long: `Delete snapshots that fall outside the retention policy on every machine
+matching --filter (an OData filter on machines, e.g. "projectId eq '...'").
+The newest --keep-last snapshots and the current snapshot of each machine are
+kept, of the rest only those older than --older-than are deleted. Machines are
+pruned in parallel, every delete waits for its request to finish.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if keepLast < 0 {
+				return fmt.Errorf("--keep-last must not be negative")
+			}
+			policy := snapshots.Policy{KeepLast: keepLast}
+			if olderThan != "" {
+				d, err := cmdutil.ParseAge(olderThan)
+				if err != nil {
+					return err
+				}
+				if d <= 0 {
+					return fmt.Errorf("--older-than must be positive")
+				}
+				policy.OlderThan = d
+			}
+			if policy.KeepLast == 0 && policy.OlderThan == 0 {
+				return fmt.Errorf("set --keep-last and/or --older-than")
+			}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			machines, err := c.ListMachines(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			var results []snapshots.Result
+			p := &snapshots.Pruner{
+				Client:      c,
+				Policy:      policy,
+				Concurrency: concurrency,
+				DryRun:      dryRun,
+				OnResult:    func(r snapshots.Result) { results = append(results, r) },
+			}
+			runErr := p.Run(cmd.Context(), machines)
+			err = cmdutil.Print(cmd.OutOrStdout(), output, results, []string{"machine", "snapshot", "created", "status", "error"}, func() [][]string {
+				var rows [][]string
+				for _, r := range results {
+					rows = append(rows, []string{r.Machine, r.SnapshotID, r.CreatedAt, r.Status, r.Error})
+				}
+				return rows
+			})
+			if runErr != nil {
+				return runErr
+			}
+			return err
+		},
+	}
+	cmd.Flags().IntVar(&keepLast, "keep-last", 0, "snapshots to keep per machine, newest first")
+	cmd.Flags().StringVar(&olderThan, "older-than", "", "only delete snapshots older than this, e.g. 7d")
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter selecting the machines")
+	cmd.Flags().IntVarP(&concurrency, "concurrency", "c", 4, "machines pruned in parallel")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list what would be deleted")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
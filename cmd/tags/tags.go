@@ -0,0 +1,285 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/report"
+	"iv/pkg/tags"
+
+	"github.com/spf13/cobra"
+)
+
+func NewTagsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "tags",
+		Aliases: []string{"tag"},
+		Short:   "manage tags, set them in bulk and find the unused ones",
+	}
+	cmd.AddCommand(newListCommand(), newCreateCommand(), newDeleteCommand(), newUsageCommand(),
+		newSetCommand(), newOrphanedCommand())
+	return cmd
+}
+
+var tagHeader = []string{"id", "key", "value"}
+
+func tagRows(all []iaas.Tag) [][]string {
+	var rows [][]string
+	for _, t := range all {
+		rows = append(rows, []string{t.ID, t.Key, t.Value})
+	}
+	return rows
+}
+
+// lookup finds the tag with exactly this key and value
+func lookup(cmd *cobra.Command, c *iaas.Client, arg string) (*iaas.Tag, error) {
+	want, err := iaas.ParseTag(arg)
+	if err != nil {
+		return nil, err
+	}
+	found, err := c.FindTags(cmd.Context(), want.Key, want.Value)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range found {
+		if t.Key == want.Key && t.Value == want.Value {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("tag %s not found", arg)
+}
+
+func newListCommand() *cobra.Command {
+	var filter, key, output string
+	cmd := &cobra.Command{
+		Use:   "list [--key <key>] [--filter ...]",
+		Short: "list tags",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			if key != "" {
+				keyFilter := "key eq " + iaas.Quote(key)
+				if filter != "" {
+					filter = "(" + filter + ") and " + keyFilter
+				} else {
+					filter = keyFilter
+				}
+			}
+			all, err := c.ListTags(cmd.Context(), &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, all, tagHeader, func() [][]string { return tagRows(all) })
+		},
+	}
+	cmd.Flags().StringVar(&key, "key", "", "only tags with this key")
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter, e.g. \"value eq 'prod'\"")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newCreateCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "create <key=value>...",
+		Short: "create tags",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			var created []iaas.Tag
+			for _, arg := range args {
+				t, err := iaas.ParseTag(arg)
+				if err != nil {
+					return err
+				}
+				n, err := c.CreateTag(cmd.Context(), t.Key, t.Value)
+				if err != nil {
+					return err
+				}
+				created = append(created, *n)
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, created, tagHeader, func() [][]string { return tagRows(created) })
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newDeleteCommand() *cobra.Command {
+	var ignoreUsage bool
+	cmd := &cobra.Command{
+		Use:   "delete <key=value>...",
+		Short: "delete tags, a tag still in use is refused unless --ignore-usage",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			for _, arg := range args {
+				t, err := lookup(cmd, c, arg)
+				if err != nil {
+					return err
+				}
+				if err := c.DeleteTag(cmd.Context(), t.ID, ignoreUsage); err != nil {
+					return fmt.Errorf("%s: %w", arg, err)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "tag %s deleted (%s)\n", arg, t.ID)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&ignoreUsage, "ignore-usage", false, "remove the tag from its resources and delete it")
+	return cmd
+}
+
+func newUsageCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "usage <key=value>...",
+		Short: "list the resources that carry all of the given tags",
+		Long: `List the resources that carry all of the given tags. Every collection whose
+resources carry tags is read, tags-usage on the server does not answer with
+the resources.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			var want []iaas.Tag
+			for _, arg := range args {
+				t, err := lookup(cmd, c, arg)
+				if err != nil {
+					return err
+				}
+				want = append(want, *t)
+			}
+			idx, err := c.IndexTags(cmd.Context())
+			if err != nil {
+				return err
+			}
+			used := idx.Using(want)
+			return cmdutil.Print(cmd.OutOrStdout(), output, used, []string{"id", "name", "collection"}, func() [][]string {
+				var rows [][]string
+				for _, r := range used {
+					rows = append(rows, []string{r.ID, r.Name, strings.TrimPrefix(r.Collection, "/")})
+				}
+				return rows
+			})
+		},
+	}
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+// first returns the first of the fields that is a non empty string
+func first(o iaas.Object, fields ...string) string {
+	for _, f := range fields {
+		if s, ok := o[f].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func newSetCommand() *cobra.Command {
+	var kind, filter, output string
+	var concurrency int
+	var dryRun bool
+	kinds := make([]string, 0, len(iaas.TagCollections))
+	for k := range iaas.TagCollections {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	cmd := &cobra.Command{
+		Use:   "set <key=value | key->... --kind machines [--filter ...]",
+		Short: "set or remove tags on every resource of a kind that matches a filter",
+		Long: `Set tags on many resources at once: key=value sets a tag, replacing the
+values the key had, and key- removes the key. Other tags are left alone.
+Resources are patched in parallel; --dry-run shows the result first.`,
+		Example: `  iv tags set costcenter=4711 env- --kind machines --filter "projectId eq '<id>'" --dry-run`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			collection, ok := iaas.TagCollections[kind]
+			if !ok {
+				return fmt.Errorf("unknown kind %q (supported: %s)", kind, strings.Join(kinds, ", "))
+			}
+			set, remove, err := iaas.ParseTagChanges(args)
+			if err != nil {
+				return err
+			}
+			b := &tags.Bulk{Collection: collection, Set: set, Remove: remove, Concurrency: concurrency, DryRun: dryRun}
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			b.Client = c
+			objs, err := c.ListObjects(cmd.Context(), collection, &iaas.ListOptions{Filter: filter})
+			if err != nil {
+				return err
+			}
+			var results []tags.Result
+			b.OnResult = func(r tags.Result) { results = append(results, r) }
+			runErr := b.Run(cmd.Context(), objs)
+			sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+			err = cmdutil.Print(cmd.OutOrStdout(), output, results, []string{"id", "name", "status", "tags"}, func() [][]string {
+				var rows [][]string
+				for _, r := range results {
+					var after []string
+					for _, t := range r.After {
+						after = append(after, t.Key+"="+t.Value)
+					}
+					rows = append(rows, []string{r.ID, r.Name, r.Status, strings.Join(after, ",")})
+				}
+				return rows
+			})
+			if runErr != nil {
+				return runErr
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&kind, "kind", "machines", "kind of resource: "+strings.Join(kinds, ", "))
+	cmd.RegisterFlagCompletionFunc("kind", cobra.FixedCompletions(kinds, cobra.ShellCompDirectiveNoFileComp))
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter selecting the resources, all when empty")
+	cmd.Flags().IntVarP(&concurrency, "concurrency", "c", 8, "resources patched at once")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "show the resulting tags without changing anything")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newOrphanedCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "orphaned [--filter ...]",
+		Short: "report tags that no resource uses",
+		Long: `Report the tags that no resource carries. Every collection whose resources
+carry tags is read once; a collection that cannot be read in full fails the
+report. Tags only named in constraints are reported as unused.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			orphans, err := report.OrphanedTags(cmd.Context(), c, filter)
+			if perr := cmdutil.Print(cmd.OutOrStdout(), output, orphans, tagHeader, func() [][]string { return tagRows(orphans) }); perr != nil {
+				return perr
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter on the tags to check")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
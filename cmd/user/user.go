@@ -0,0 +1,164 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"iv/cmd/cmdutil"
+	vra8 "iv/pkg/endpoints/vra/auth"
+	"iv/pkg/endpoints/vra/iam"
+
+	"github.com/spf13/cobra"
+)
+
+func NewUserCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "manage a user's access",
+	}
+	cmd.AddCommand(newRolesCommand())
+	return cmd
+}
+
+func newRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "roles",
+		Short: "get, grant and revoke a user's org and service roles",
+	}
+	cmd.AddCommand(newGetCommand(), newPatchCommand(true), newPatchCommand(false))
+	return cmd
+}
+
+// roleRow is one role a user holds, for printing
+type roleRow struct {
+	Kind    string `json:"kind"`
+	Service string `json:"service,omitempty"`
+	Role    string `json:"role"`
+}
+
+func newGetCommand() *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "get <user-id>",
+		Short: "show the org and service roles of a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			orgRoles, err := c.GetUserOrgRoles(cmd.Context(), args[0], org)
+			if err != nil {
+				return err
+			}
+			svcRoles, err := c.GetUserServiceRoles(cmd.Context(), args[0], org)
+			if err != nil {
+				return err
+			}
+			var rows []roleRow
+			for _, r := range orgRoles {
+				rows = append(rows, roleRow{Kind: "org", Role: iam.Str(r.Name)})
+			}
+			for _, s := range svcRoles {
+				if s.ServiceRoleNames == nil {
+					continue
+				}
+				for _, name := range *s.ServiceRoleNames {
+					rows = append(rows, roleRow{Kind: "service", Service: iam.ServiceID(iam.Str(s.ServiceDefinitionLink)), Role: name})
+				}
+			}
+			return cmdutil.Print(cmd.OutOrStdout(), output, rows,
+				[]string{"kind", "service", "role"},
+				func() [][]string {
+					var out [][]string
+					for _, r := range rows {
+						out = append(out, []string{r.Kind, r.Service, r.Role})
+					}
+					return out
+				})
+		},
+	}
+	cmdutil.AddOrgFlag(cmd)
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
+
+func newPatchCommand(grant bool) *cobra.Command {
+	var orgRoles, serviceRoles []string
+	use, short := "revoke", "remove org or service roles from a user"
+	if grant {
+		use, short = "grant", "give a user org or service roles"
+	}
+	cmd := &cobra.Command{
+		Use:   use + " <user-id>",
+		Short: short,
+		Long: short + `
+
+Org roles are plain names (org_member, org_owner). Service roles are
+<service-definition-id>:<role-name>.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(orgRoles) == 0 && len(serviceRoles) == 0 {
+				return fmt.Errorf("nothing to %s, pass --role or --service-role", use)
+			}
+			req, err := rolePatch(orgRoles, serviceRoles, grant)
+			if err != nil {
+				return err
+			}
+			c, org, err := cmdutil.NewIAMClientForOrg(cmd)
+			if err != nil {
+				return err
+			}
+			if err := c.UpdateUserRoles(cmd.Context(), args[0], org, req); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s: roles updated in org %s\n", args[0], org)
+			return nil
+		},
+	}
+	cmd.Flags().StringSliceVar(&orgRoles, "role", nil, "org role name (repeatable)")
+	cmd.Flags().StringSliceVar(&serviceRoles, "service-role", nil, "service role as <service-definition-id>:<role> (repeatable)")
+	cmdutil.AddOrgFlag(cmd)
+	return cmd
+}
+
+// rolePatch builds the v3 role patch adding (grant) or removing roles
+func rolePatch(orgRoles, serviceRoles []string, grant bool) (vra8.UpdateMemberRolesRequest, error) {
+	var req vra8.UpdateMemberRolesRequest
+	if len(orgRoles) > 0 {
+		names := append([]string(nil), orgRoles...)
+		req.OrganizationRoles = &vra8.UpdateRolesRequestBaseRoleBinding{}
+		if grant {
+			req.OrganizationRoles.RoleNamesToAdd = &names
+		} else {
+			req.OrganizationRoles.RoleNamesToRemove = &names
+		}
+	}
+	bySvc := map[string][]string{}
+	var order []string
+	for _, sr := range serviceRoles {
+		svc, role, ok := strings.Cut(sr, ":")
+		if !ok || svc == "" || role == "" {
+			return req, fmt.Errorf("service role %q is not <service-definition-id>:<role>", sr)
+		}
+		if _, seen := bySvc[svc]; !seen {
+			order = append(order, svc)
+		}
+		bySvc[svc] = append(bySvc[svc], role)
+	}
+	if len(order) > 0 {
+		var svcs []vra8.UpdateServiceRolesRequest
+		for _, svc := range order {
+			id, names := svc, bySvc[svc]
+			u := vra8.UpdateServiceRolesRequest{ServiceDefinitionId: &id}
+			if grant {
+				u.RoleNamesToAdd = &names
+			} else {
+				u.RoleNamesToRemove = &names
+			}
+			svcs = append(svcs, u)
+		}
+		req.ServiceRoles = &svcs
+	}
+	return req, nil
+}
@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"fmt"
+
+	"iv/cmd/cmdutil"
+	"iv/pkg/validate"
+
+	"github.com/spf13/cobra"
+)
+
+func NewValidateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "check the vRA configuration for gaps",
+	}
+	cmd.AddCommand(newMappingsCommand())
+	return cmd
+}
+
+func newMappingsCommand() *cobra.Command {
+	var filter, output string
+	cmd := &cobra.Command{
+		Use:   "mappings [--filter ...]",
+		Short: "check that flavors and images are mapped in every region projects use",
+		Long: `Check that every logical flavor and image of the flavor and image profiles
+is mapped in every region the zones of the projects are in, and that the
+fabric flavors and images the mappings refer to still exist. The gaps are
+listed with the projects they affect and the command fails if there are any.`,
+		Example: `  iv validate mappings
+  iv validate mappings --filter "name eq 'dev'" -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := cmdutil.NewIaaSClient(cmd)
+			if err != nil {
+				return err
+			}
+			gaps, err := validate.Mappings(cmd.Context(), c, filter)
+			if err != nil {
+				return err
+			}
+			err = cmdutil.Print(cmd.OutOrStdout(), output, gaps, validate.GapHeader, func() [][]string {
+				var rows [][]string
+				for _, g := range gaps {
+					rows = append(rows, g.Strings())
+				}
+				return rows
+			})
+			if err != nil {
+				return err
+			}
+			if len(gaps) > 0 {
+				return fmt.Errorf("%d mapping gaps", len(gaps))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&filter, "filter", "", "OData filter on the projects to check, all when unset")
+	cmdutil.AddOutputFlag(cmd, &output)
+	return cmd
+}
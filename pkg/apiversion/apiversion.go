@@ -0,0 +1,231 @@
+package apiversion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"iv/pkg/cache"
+	"iv/pkg/rest"
+)
+
+// package apiversion picks the API version iv talks to each vRA service with
+// from the service's about endpoint and adds it as the apiVersion query
+// parameter, so requests do not silently fall back to the server's default
+
+// TTL is how long the about answer of a service is kept per profile, a day
+// picks up appliance upgrades without asking on every invocation; the
+// version is negotiated from it anew by every process
+const TTL = 24 * time.Hour
+
+// dateLayout is the yyyy-MM-dd format of versions and deprecation dates
+const dateLayout = "2006-01-02"
+
+// About lists the API versions a service supports
+type About struct {
+	SupportedAPIs    []APIDescription `json:"supportedApis"`
+	LatestAPIVersion string           `json:"latestApiVersion"`
+}
+
+// APIDescription is one supported API version
+type APIDescription struct {
+	APIVersion        string             `json:"apiVersion"`
+	DocumentationLink string             `json:"documentationLink,omitempty"`
+	DeprecationPolicy *DeprecationPolicy `json:"deprecationPolicy,omitempty"`
+}
+
+// DeprecationPolicy tells since when a version is deprecated and when it
+// stops working
+type DeprecationPolicy struct {
+	DeprecatedAt string `json:"deprecatedAt,omitempty"`
+	ExpiresAt    string `json:"expiresAt,omitempty"`
+	Description  string `json:"description,omitempty"`
+}
+
+// Service is a vRA API that takes apiVersion, Supported are the versions the
+// client is written against
+type Service struct {
+	Name      string
+	Prefix    string
+	Supported []string
+}
+
+// Choice is the outcome of negotiating with a service; an empty Version
+// leaves the server default in place
+type Choice struct {
+	Version string
+	Warning string
+}
+
+// answer is the about answer of a service, Error is set when the service
+// has no usable about endpoint; only answers with About are cached
+type answer struct {
+	Server string `json:"server"`
+	About  *About `json:"about,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Negotiate picks the newest version both the service and the client
+// support, and warns when it is deprecated or past its sunset at now
+func Negotiate(s Service, a *About, now time.Time) Choice {
+	offered := map[string]APIDescription{}
+	for _, d := range a.SupportedAPIs {
+		offered[d.APIVersion] = d
+	}
+	supported := append([]string(nil), s.Supported...)
+	sort.Sort(sort.Reverse(sort.StringSlice(supported)))
+	for _, v := range supported {
+		d, ok := offered[v]
+		if !ok {
+			continue
+		}
+		return Choice{Version: v, Warning: deprecation(s, d, now)}
+	}
+	var versions []string
+	for v := range offered {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+	return Choice{Warning: fmt.Sprintf("%s offers API versions %s but iv supports %s, using the server default",
+		s.Name, strings.Join(versions, ", "), strings.Join(s.Supported, ", "))}
+}
+
+func deprecation(s Service, d APIDescription, now time.Time) string {
+	p := d.DeprecationPolicy
+	if p == nil {
+		return ""
+	}
+	var msg string
+	if expires, ok := date(p.ExpiresAt); ok && !now.Before(expires) {
+		msg = fmt.Sprintf("%s API version %s expired on %s", s.Name, d.APIVersion, p.ExpiresAt[:len(dateLayout)])
+	} else if deprecated, ok := date(p.DeprecatedAt); ok && !now.Before(deprecated) {
+		msg = fmt.Sprintf("%s API version %s is deprecated since %s", s.Name, d.APIVersion, p.DeprecatedAt[:len(dateLayout)])
+		if _, ok := date(p.ExpiresAt); ok {
+			msg += ", support ends " + p.ExpiresAt[:len(dateLayout)]
+		}
+	} else {
+		return ""
+	}
+	if p.Description != "" {
+		msg += ": " + p.Description
+	}
+	return msg
+}
+
+// date reads yyyy-MM-dd, a time of day after it is ignored
+func date(s string) (time.Time, bool) {
+	if len(s) < len(dateLayout) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(dateLayout, s[:len(dateLayout)])
+	return t, err == nil
+}
+
+// Negotiator is a request editor that adds apiVersion to every request of
+// its services, asking a service's about endpoint on first use
+type Negotiator struct {
+	Client *rest.Client
+	// Namespace is the cache namespace the about answers are kept in, one
+	// per profile; empty asks every service once per process
+	Namespace string
+	Services  []Service
+	// Warn is told about deprecated versions and failed negotiations, each
+	// message once
+	Warn func(msg string)
+
+	mu     sync.Mutex
+	chosen map[string]Choice
+	warned map[string]bool
+}
+
+// Edit implements rest.RequestEditorFn
+func (n *Negotiator) Edit(ctx context.Context, req *http.Request) error {
+	path := req.URL.Path
+	if strings.HasSuffix(path, "/about") {
+		return nil
+	}
+	q := req.URL.Query()
+	if q.Has("apiVersion") {
+		return nil
+	}
+	for _, s := range n.Services {
+		if !strings.Contains(path, s.Prefix+"/") {
+			continue
+		}
+		if c := n.choice(ctx, s); c.Version != "" {
+			q.Set("apiVersion", c.Version)
+			req.URL.RawQuery = q.Encode()
+		}
+		return nil
+	}
+	return nil
+}
+
+func (n *Negotiator) choice(ctx context.Context, s Service) Choice {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if c, ok := n.chosen[s.Name]; ok {
+		return c
+	}
+	ans, ok := n.about(ctx, s)
+	if !ok {
+		// without an answer the request itself fails and says why, ask
+		// again on the next request
+		return Choice{}
+	}
+	if ans.About == nil {
+		// a failed about is not kept either, a stale token or a restarting
+		// service gets its answer on a later request
+		n.warn(fmt.Sprintf("cannot negotiate the %s API version, using the server default: %s", s.Name, ans.Error))
+		return Choice{}
+	}
+	c := Negotiate(s, ans.About, time.Now())
+	if n.chosen == nil {
+		n.chosen = map[string]Choice{}
+	}
+	n.chosen[s.Name] = c
+	if c.Warning != "" {
+		n.warn(c.Warning)
+	}
+	return c
+}
+
+// about returns the about answer of a service from the cache or the server,
+// false when the server could not be reached; only answers with the
+// supported versions are cached
+func (n *Negotiator) about(ctx context.Context, s Service) (answer, bool) {
+	var ans answer
+	if n.Namespace != "" && cache.Get(n.Namespace, s.Name, TTL, &ans) && ans.Server == n.Client.Server && ans.About != nil {
+		return ans, true
+	}
+	ans = answer{Server: n.Client.Server}
+	var a About
+	if err := n.Client.Get(ctx, s.Prefix+"/about", nil, &a); err != nil {
+		if rest.StatusCode(err) == 0 {
+			return ans, false
+		}
+		ans.Error = err.Error()
+		return ans, true
+	}
+	ans.About = &a
+	if n.Namespace != "" {
+		_ = cache.Put(n.Namespace, s.Name, ans)
+	}
+	return ans, true
+}
+
+// warn tells about a message once per negotiator
+func (n *Negotiator) warn(msg string) {
+	if n.Warn == nil || n.warned[msg] {
+		return
+	}
+	if n.warned == nil {
+		n.warned = map[string]bool{}
+	}
+	n.warned[msg] = true
+	n.Warn(msg)
+}
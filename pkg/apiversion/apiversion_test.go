@@ -0,0 +1,157 @@
+package apiversion
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"iv/pkg/rest"
+)
+
+var iaas = Service{Name: "iaas", Prefix: "/iaas/api", Supported: []string{"2019-01-15", "2021-07-15"}}
+
+func TestNegotiate(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	offer := func(descs ...APIDescription) *About { return &About{SupportedAPIs: descs} }
+	for _, tt := range []struct {
+		name    string
+		about   *About
+		version string
+		warning string
+	}{
+		{"newest common version", offer(APIDescription{APIVersion: "2019-01-15"}, APIDescription{APIVersion: "2021-07-15"}, APIDescription{APIVersion: "2024-01-01"}),
+			"2021-07-15", ""},
+		{"older common version", offer(APIDescription{APIVersion: "2019-01-15"}), "2019-01-15", ""},
+		{"nothing in common", offer(APIDescription{APIVersion: "2024-01-01"}, APIDescription{APIVersion: "2023-01-01"}), "",
+			"iaas offers API versions 2023-01-01, 2024-01-01 but iv supports 2019-01-15, 2021-07-15, using the server default"},
+		{"deprecation announced later", offer(APIDescription{APIVersion: "2021-07-15", DeprecationPolicy: &DeprecationPolicy{DeprecatedAt: "2025-12-01"}}),
+			"2021-07-15", ""},
+		{"deprecated", offer(APIDescription{APIVersion: "2021-07-15", DeprecationPolicy: &DeprecationPolicy{DeprecatedAt: "2025-01-01T00:00:00Z", ExpiresAt: "2026-01-01", Description: "move to 2025-01-01"}}),
+			"2021-07-15", "iaas API version 2021-07-15 is deprecated since 2025-01-01, support ends 2026-01-01: move to 2025-01-01"},
+		{"deprecated without sunset", offer(APIDescription{APIVersion: "2021-07-15", DeprecationPolicy: &DeprecationPolicy{DeprecatedAt: "2025-06-01"}}),
+			"2021-07-15", "iaas API version 2021-07-15 is deprecated since 2025-06-01"},
+		{"expired", offer(APIDescription{APIVersion: "2021-07-15", DeprecationPolicy: &DeprecationPolicy{DeprecatedAt: "2024-01-01", ExpiresAt: "2025-05-31"}}),
+			"2021-07-15", "iaas API version 2021-07-15 expired on 2025-05-31"},
+		{"unreadable dates", offer(APIDescription{APIVersion: "2021-07-15", DeprecationPolicy: &DeprecationPolicy{DeprecatedAt: "soon"}}),
+			"2021-07-15", ""},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Negotiate(iaas, tt.about, now)
+			if c.Version != tt.version || c.Warning != tt.warning {
+				t.Errorf("Negotiate() = %+v, want %q, %q", c, tt.version, tt.warning)
+			}
+		})
+	}
+}
+
+// server answers about with body and status, and records every other request
+type server struct {
+	*httptest.Server
+	status  atomic.Int32
+	abouts  atomic.Int32
+	queries chan string
+}
+
+func newServer(t *testing.T, body string) *server {
+	s := &server{queries: make(chan string, 10)}
+	s.status.Store(http.StatusOK)
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/about") {
+			s.abouts.Add(1)
+			w.WriteHeader(int(s.status.Load()))
+			w.Write([]byte(body))
+			return
+		}
+		s.queries <- r.URL.RawQuery
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// get sends one request through a negotiator and returns its query
+func (s *server) get(t *testing.T, n *Negotiator, path string) string {
+	t.Helper()
+	if err := n.Client.Get(context.Background(), path, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	return <-s.queries
+}
+
+func negotiator(t *testing.T, s *server, namespace string, warnings *[]string) *Negotiator {
+	t.Helper()
+	n := &Negotiator{Namespace: namespace, Services: []Service{iaas}, Warn: func(msg string) { *warnings = append(*warnings, msg) }}
+	c, err := rest.NewClient(s.URL, rest.WithRequestEditorFn(n.Edit))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Client = c
+	return n
+}
+
+func TestNegotiatorEdit(t *testing.T) {
+	t.Setenv("IV_HOME", t.TempDir())
+	s := newServer(t, `{"supportedApis":[{"apiVersion":"2021-07-15","deprecationPolicy":{"deprecatedAt":"2020-01-01"}}]}`)
+	var warnings []string
+	n := negotiator(t, s, "test", &warnings)
+
+	if q := s.get(t, n, "/iaas/api/machines"); q != "apiVersion=2021-07-15" {
+		t.Errorf("query = %q", q)
+	}
+	if q := s.get(t, n, "/iaas/api/zones?apiVersion=2019-01-15"); q != "apiVersion=2019-01-15" {
+		t.Errorf("an explicit apiVersion was replaced: %q", q)
+	}
+	if q := s.get(t, n, "/project-service/api/projects"); q != "" {
+		t.Errorf("another service got %q", q)
+	}
+	if got := s.abouts.Load(); got != 1 {
+		t.Errorf("about asked %d times, want once", got)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "deprecated since 2020-01-01") {
+		t.Errorf("warnings = %q", warnings)
+	}
+
+	// a new process takes the answer from the cache but warns again
+	n = negotiator(t, s, "test", &warnings)
+	if q := s.get(t, n, "/iaas/api/machines"); q != "apiVersion=2021-07-15" {
+		t.Errorf("query = %q", q)
+	}
+	if got := s.abouts.Load(); got != 1 || len(warnings) != 2 {
+		t.Errorf("about asked %d times with warnings %q, want the cache and a second warning", got, warnings)
+	}
+}
+
+func TestNegotiatorFailedAbout(t *testing.T) {
+	t.Setenv("IV_HOME", t.TempDir())
+	s := newServer(t, `{"supportedApis":[{"apiVersion":"2021-07-15"}]}`)
+	s.status.Store(http.StatusUnauthorized)
+	var warnings []string
+	n := negotiator(t, s, "test", &warnings)
+
+	for range 2 {
+		if q := s.get(t, n, "/iaas/api/machines"); q != "" {
+			t.Errorf("query after a failed about = %q", q)
+		}
+	}
+	if got := s.abouts.Load(); got != 2 {
+		t.Errorf("about asked %d times, want on every request while it fails", got)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "cannot negotiate the iaas API version") {
+		t.Errorf("warnings = %q, want one", warnings)
+	}
+
+	// the failure is neither pinned in the process nor cached
+	s.status.Store(http.StatusOK)
+	if q := s.get(t, n, "/iaas/api/machines"); q != "apiVersion=2021-07-15" {
+		t.Errorf("query once about answers = %q", q)
+	}
+	n = negotiator(t, s, "test", &warnings)
+	s.get(t, n, "/iaas/api/machines")
+	if got := s.abouts.Load(); got != 3 {
+		t.Errorf("about asked %d times, want the good answer cached", got)
+	}
+}
@@ -0,0 +1,148 @@
+package batch
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// package batch runs a file of REST operations, one JSON object per line,
+// against a vRA instance with bounded concurrency
+//
+//	{"id":"proj","method":"POST","path":"/iaas/api/projects","body":{"name":"p1"}}
+//	{"operationId":"getProject","params":{"id":"${proj.response.id}"},"dependsOn":["proj"]}
+
+// Step is a single line of the batch file
+type Step struct {
+	ID          string         `json:"id,omitempty"`
+	Method      string         `json:"method,omitempty"`
+	Path        string         `json:"path,omitempty"`
+	OperationID string         `json:"operationId,omitempty"`
+	Params      map[string]any `json:"params,omitempty"`
+	Body        any            `json:"body,omitempty"`
+	DependsOn   []string       `json:"dependsOn,omitempty"`
+
+	// Line is the 1-based line number in the batch file
+	Line int `json:"-"`
+	// deps are the resolved step indexes this step waits on
+	deps []int
+}
+
+// Key identifies the step in results, the ID if set, else the line number
+func (s *Step) Key() string {
+	if s.ID != "" {
+		return s.ID
+	}
+	return fmt.Sprintf("line-%d", s.Line)
+}
+
+// Parse reads the batch file, blank lines and lines starting with # are skipped
+// dependencies (explicit and implied by ${...} references) are resolved here
+// so a bad file fails before anything is sent
+func Parse(r io.Reader) ([]*Step, error) {
+	var steps []*Step
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for sc.Scan() {
+		line++
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		s := &Step{}
+		if err := json.Unmarshal([]byte(text), s); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if s.Path == "" && s.OperationID == "" {
+			return nil, fmt.Errorf("line %d: either path or operationId is required", line)
+		}
+		s.Line = line
+		steps = append(steps, s)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if err := resolveDeps(steps); err != nil {
+		return nil, err
+	}
+	return steps, nil
+}
+
+func resolveDeps(steps []*Step) error {
+	byID := map[string]int{}
+	for i, s := range steps {
+		if s.ID == "" {
+			continue
+		}
+		if _, dup := byID[s.ID]; dup {
+			return fmt.Errorf("line %d: duplicate id %q", s.Line, s.ID)
+		}
+		byID[s.ID] = i
+	}
+	for i, s := range steps {
+		seen := map[int]bool{}
+		add := func(j int) {
+			if !seen[j] {
+				seen[j] = true
+				s.deps = append(s.deps, j)
+			}
+		}
+		for _, id := range s.DependsOn {
+			j, ok := byID[id]
+			if !ok {
+				return fmt.Errorf("line %d: depends on unknown id %q", s.Line, id)
+			}
+			add(j)
+		}
+		for _, ref := range references(s) {
+			if ref == previousRef {
+				if i == 0 {
+					return fmt.Errorf("line %d: ${previous...} used on the first step", s.Line)
+				}
+				add(i - 1)
+				continue
+			}
+			j, ok := byID[ref]
+			if !ok {
+				return fmt.Errorf("line %d: references unknown id %q", s.Line, ref)
+			}
+			add(j)
+		}
+	}
+	return checkCycles(steps)
+}
+
+func checkCycles(steps []*Step) error {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make([]int, len(steps))
+	var visit func(i int) error
+	visit = func(i int) error {
+		switch state[i] {
+		case visiting:
+			return fmt.Errorf("line %d: dependency cycle", steps[i].Line)
+		case done:
+			return nil
+		}
+		state[i] = visiting
+		for _, j := range steps[i].deps {
+			if err := visit(j); err != nil {
+				return err
+			}
+		}
+		state[i] = done
+		return nil
+	}
+	for i := range steps {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package batch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDeps(t *testing.T) {
+	steps, err := Parse(strings.NewReader(`# create and read back
+{"id":"proj","method":"POST","path":"/iaas/api/projects","body":{"name":"p1"}}
+
+{"id":"zone","path":"/iaas/api/zones/${proj.response.zoneId}"}
+{"path":"/iaas/api/projects/{id}","params":{"id":"${previous.response.id}"},"dependsOn":["proj","proj"]}
+{"operationId":"getProjects"}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []int
+	var deps [][]int
+	for _, s := range steps {
+		lines, deps = append(lines, s.Line), append(deps, s.deps)
+	}
+	if want := []int{2, 4, 5, 6}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("lines = %v, want %v", lines, want)
+	}
+	// explicit and implied dependencies are merged, each step once
+	if want := [][]int{nil, {0}, {0, 1}, nil}; !reflect.DeepEqual(deps, want) {
+		t.Errorf("deps = %v, want %v", deps, want)
+	}
+	if got := steps[2].Key(); got != "line-5" {
+		t.Errorf("Key() = %q, want line-5", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct {
+		file, err string
+	}{
+		{`{"method":"GET"}`, "line 1: either path or operationId is required"},
+		{`{"path":"/a"` + "\n" + `not json`, "line 1:"},
+		{`{"id":"a","path":"/a"}` + "\n" + `{"id":"a","path":"/b"}`, `line 2: duplicate id "a"`},
+		{`{"path":"/a","dependsOn":["b"]}`, `line 1: depends on unknown id "b"`},
+		{`{"path":"/a/${b.response.id}"}`, `line 1: references unknown id "b"`},
+		{`{"path":"/a/${previous.response.id}"}`, "line 1: ${previous...} used on the first step"},
+		{`{"id":"a","path":"/a","dependsOn":["a"]}`, "line 1: dependency cycle"},
+		{`{"id":"a","path":"/a","body":{"x":"${c.response}"}}` + "\n" +
+			`{"id":"b","path":"/b","dependsOn":["a"]}` + "\n" +
+			`{"id":"c","path":"/c/${previous.response.id}"}`, "dependency cycle"},
+	} {
+		_, err := Parse(strings.NewReader(tt.file))
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Parse(%q) = %v, want %q", tt.file, err, tt.err)
+		}
+	}
+}
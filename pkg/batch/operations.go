@@ -0,0 +1,61 @@
+package batch
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Operation is the method and path template behind an operationId
+type Operation struct {
+	Method string
+	Path   string
+}
+
+// Operations maps operationId to its HTTP operation
+type Operations map[string]Operation
+
+// LoadOperations indexes the operationIds of the given OpenAPI specs
+// (e.g. the vRA IaaS, Project Service and auth specs)
+func LoadOperations(files ...string) (Operations, error) {
+	ops := Operations{}
+	loader := openapi3.NewLoader()
+	for _, f := range files {
+		doc, err := loader.LoadFromFile(f)
+		if err != nil {
+			return nil, fmt.Errorf("loading spec %s: %w", f, err)
+		}
+		for path, item := range doc.Paths.Map() {
+			for method, op := range item.Operations() {
+				if op.OperationID == "" {
+					continue
+				}
+				ops[op.OperationID] = Operation{Method: strings.ToUpper(method), Path: path}
+			}
+		}
+	}
+	return ops, nil
+}
+
+// resolve fills in method and path for a step, explicit values win
+func (ops Operations) resolve(s *Step) (Operation, error) {
+	op := Operation{Method: strings.ToUpper(s.Method), Path: s.Path}
+	if s.OperationID != "" {
+		known, ok := ops[s.OperationID]
+		if !ok {
+			return op, fmt.Errorf("unknown operationId %q (pass the spec with --spec)", s.OperationID)
+		}
+		if op.Method == "" {
+			op.Method = known.Method
+		}
+		if op.Path == "" {
+			op.Path = known.Path
+		}
+	}
+	if op.Method == "" {
+		op.Method = http.MethodGet
+	}
+	return op, nil
+}
@@ -0,0 +1,286 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"iv/pkg/rest"
+)
+
+const (
+	StatusOK      = "ok"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+// Result is written as one JSON line per step
+type Result struct {
+	Line       int             `json:"line"`
+	ID         string          `json:"id,omitempty"`
+	Status     string          `json:"status"`
+	Method     string          `json:"method,omitempty"`
+	Path       string          `json:"path,omitempty"`
+	StatusCode int             `json:"statusCode,omitempty"`
+	DurationMs int64           `json:"durationMs"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	Error      string          `json:"error,omitempty"`
+}
+
+// Summary counts the outcome of a run
+type Summary struct {
+	Total     int           `json:"total"`
+	Succeeded int           `json:"succeeded"`
+	Failed    int           `json:"failed"`
+	Skipped   int           `json:"skipped"`
+	Duration  time.Duration `json:"duration"`
+}
+
+func (s Summary) String() string {
+	return fmt.Sprintf("%d steps: %d ok, %d failed, %d skipped in %s",
+		s.Total, s.Succeeded, s.Failed, s.Skipped, s.Duration.Round(time.Millisecond))
+}
+
+// Runner executes parsed steps
+type Runner struct {
+	Client     *rest.Client
+	Operations Operations
+	// Concurrency bounds the in-flight requests, defaults to 1
+	Concurrency int
+	// ContinueOnError keeps scheduling independent steps after a failure,
+	// steps depending on a failed step are always skipped
+	ContinueOnError bool
+	// OnResult is called once per step, from a single goroutine
+	OnResult func(Result)
+
+	mu        sync.RWMutex
+	responses map[int]any
+}
+
+type outcome struct {
+	index  int
+	result Result
+	body   any
+}
+
+// Run executes the steps honouring their dependencies
+func (r *Runner) Run(ctx context.Context, steps []*Step) Summary {
+	start := time.Now()
+	r.responses = map[int]any{}
+	conc := r.Concurrency
+	if conc < 1 {
+		conc = 1
+	}
+
+	pending := make([]int, len(steps))
+	dependents := make([][]int, len(steps))
+	var ready []int
+	for i, s := range steps {
+		pending[i] = len(s.deps)
+		for _, j := range s.deps {
+			dependents[j] = append(dependents[j], i)
+		}
+		if pending[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	sum := Summary{Total: len(steps)}
+	finished := make([]bool, len(steps))
+	emit := func(i int, res Result) {
+		finished[i] = true
+		switch res.Status {
+		case StatusOK:
+			sum.Succeeded++
+		case StatusFailed:
+			sum.Failed++
+		default:
+			sum.Skipped++
+		}
+		if r.OnResult != nil {
+			r.OnResult(res)
+		}
+	}
+	var skip func(i int, why string)
+	skip = func(i int, why string) {
+		if finished[i] {
+			return
+		}
+		emit(i, Result{Line: steps[i].Line, ID: steps[i].ID, Status: StatusSkipped, Error: why})
+		for _, d := range dependents[i] {
+			skip(d, fmt.Sprintf("dependency %s did not succeed", steps[i].Key()))
+		}
+	}
+
+	done := make(chan outcome)
+	running := 0
+	stop := false
+	for {
+		if ctx.Err() != nil {
+			stop = true
+		}
+		for !stop && running < conc && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+			if finished[i] {
+				continue
+			}
+			running++
+			go func(i int) {
+				done <- r.execute(ctx, i, steps)
+			}(i)
+		}
+		if running == 0 {
+			break
+		}
+		o := <-done
+		running--
+		if o.result.Status == StatusOK {
+			r.mu.Lock()
+			r.responses[o.index] = o.body
+			r.mu.Unlock()
+		}
+		emit(o.index, o.result)
+		if o.result.Status != StatusOK {
+			if !r.ContinueOnError {
+				stop = true
+			}
+			for _, d := range dependents[o.index] {
+				skip(d, fmt.Sprintf("dependency %s failed", steps[o.index].Key()))
+			}
+			continue
+		}
+		for _, d := range dependents[o.index] {
+			pending[d]--
+			if pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	for i := range steps {
+		skip(i, "not run after an earlier failure")
+	}
+	sum.Duration = time.Since(start)
+	return sum
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}/]+)\}`)
+
+func (r *Runner) execute(ctx context.Context, i int, steps []*Step) outcome {
+	s := steps[i]
+	res := Result{Line: s.Line, ID: s.ID}
+	start := time.Now()
+	fail := func(err error) outcome {
+		res.Status = StatusFailed
+		res.Error = err.Error()
+		res.DurationMs = time.Since(start).Milliseconds()
+		return outcome{index: i, result: res}
+	}
+
+	op, err := r.Operations.resolve(s)
+	if err != nil {
+		return fail(err)
+	}
+	lookup := func(ref string) (any, bool) {
+		idx := -1
+		if ref == previousRef {
+			idx = i - 1
+		} else {
+			for j, o := range steps {
+				if o.ID == ref {
+					idx = j
+					break
+				}
+			}
+		}
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		v, ok := r.responses[idx]
+		return v, ok
+	}
+
+	path, err := substituteString(op.Path, lookup)
+	if err != nil {
+		return fail(err)
+	}
+	params, err := substitute(toAny(s.Params), lookup)
+	if err != nil {
+		return fail(err)
+	}
+	body, err := substitute(s.Body, lookup)
+	if err != nil {
+		return fail(err)
+	}
+	fullPath, query, err := bindParams(formatValue(path), params)
+	if err != nil {
+		return fail(err)
+	}
+	res.Method = op.Method
+	res.Path = fullPath
+
+	var raw json.RawMessage
+	rsp, err := r.Client.Do(ctx, op.Method, fullPath, query, body, &raw)
+	if rsp != nil {
+		res.StatusCode = rsp.StatusCode
+	}
+	if err != nil {
+		return fail(err)
+	}
+	res.Status = StatusOK
+	res.Response = raw
+	res.DurationMs = time.Since(start).Milliseconds()
+
+	var decoded any
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &decoded)
+	}
+	return outcome{index: i, result: res, body: decoded}
+}
+
+func toAny(m map[string]any) any {
+	if m == nil {
+		return nil
+	}
+	return m
+}
+
+// bindParams fills {name} placeholders in the path from params,
+// whatever is left over becomes the query string
+func bindParams(path string, params any) (string, url.Values, error) {
+	m, _ := params.(map[string]any)
+	used := map[string]bool{}
+	var missing string
+	out := pathParamPattern.ReplaceAllStringFunc(path, func(match string) string {
+		name := match[1 : len(match)-1]
+		v, ok := m[name]
+		if !ok {
+			if missing == "" {
+				missing = name
+			}
+			return match
+		}
+		used[name] = true
+		return url.PathEscape(formatValue(v))
+	})
+	if missing != "" {
+		return "", nil, fmt.Errorf("missing path parameter %q", missing)
+	}
+	query := url.Values{}
+	for k, v := range m {
+		if used[k] {
+			continue
+		}
+		if list, ok := v.([]any); ok {
+			for _, e := range list {
+				query.Add(k, formatValue(e))
+			}
+			continue
+		}
+		query.Add(k, formatValue(v))
+	}
+	return out, query, nil
+}
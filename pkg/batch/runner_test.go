@@ -0,0 +1,103 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"iv/pkg/rest"
+)
+
+// run serves every request from handle and runs file against it
+func run(t *testing.T, file string, r *Runner, handle func(w http.ResponseWriter, req *http.Request)) (map[string]Result, []string, Summary) {
+	t.Helper()
+	var mu sync.Mutex
+	var seen []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		seen = append(seen, req.Method+" "+req.URL.RequestURI())
+		mu.Unlock()
+		handle(w, req)
+	}))
+	defer srv.Close()
+	c, err := rest.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	steps, err := Parse(strings.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results := map[string]Result{}
+	r.Client = c
+	r.OnResult = func(res Result) { results[(&Step{ID: res.ID, Line: res.Line}).Key()] = res }
+	sum := r.Run(context.Background(), steps)
+	return results, seen, sum
+}
+
+func TestRunPassesResponses(t *testing.T) {
+	file := `{"id":"proj","method":"POST","path":"/projects","body":{"name":"p1"}}
+{"id":"get","operationId":"getProject","params":{"id":"${proj.response.id}","expand":"zones"}}
+{"path":"/zones","method":"POST","body":{"projectId":"${get.response.id}","name":"z-${previous.response.name}"}}`
+	var body map[string]any
+	results, seen, sum := run(t, file, &Runner{Operations: Operations{"getProject": {Method: "GET", Path: "/projects/{id}"}}},
+		func(w http.ResponseWriter, req *http.Request) {
+			switch req.URL.Path {
+			case "/projects":
+				w.Write([]byte(`{"id":"p-1"}`))
+			case "/projects/p-1":
+				w.Write([]byte(`{"id":"p-1","name":"p1"}`))
+			case "/zones":
+				json.NewDecoder(req.Body).Decode(&body)
+				w.WriteHeader(http.StatusCreated)
+			}
+		})
+	if sum.Succeeded != 3 {
+		t.Fatalf("summary = %s, results %+v", sum, results)
+	}
+	want := []string{"POST /projects", "GET /projects/p-1?expand=zones", "POST /zones"}
+	if strings.Join(seen, ", ") != strings.Join(want, ", ") {
+		t.Errorf("requests = %v, want %v", seen, want)
+	}
+	if body["projectId"] != "p-1" || body["name"] != "z-p1" {
+		t.Errorf("zone body = %v", body)
+	}
+}
+
+func TestRunSkipsDependents(t *testing.T) {
+	file := `{"id":"a","path":"/fail"}
+{"id":"b","path":"/b/${a.response.id}"}
+{"id":"c","path":"/c","dependsOn":["b"]}
+{"id":"d","path":"/d"}`
+	handle := func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/fail" {
+			http.Error(w, `{"message":"boom"}`, http.StatusBadRequest)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}
+
+	results, _, sum := run(t, file, &Runner{ContinueOnError: true}, handle)
+	if sum.Failed != 1 || sum.Skipped != 2 || sum.Succeeded != 1 {
+		t.Errorf("summary = %s", sum)
+	}
+	if r := results["a"]; r.Status != StatusFailed || r.StatusCode != http.StatusBadRequest {
+		t.Errorf("a = %+v", r)
+	}
+	if r := results["b"]; r.Status != StatusSkipped || r.Error != "dependency a failed" {
+		t.Errorf("b = %+v", r)
+	}
+	if r := results["c"]; r.Status != StatusSkipped || r.Error != "dependency b did not succeed" {
+		t.Errorf("c = %+v", r)
+	}
+
+	// without ContinueOnError the independent step is not started either
+	results, seen, _ := run(t, file, &Runner{}, handle)
+	if r := results["d"]; r.Status != StatusSkipped || len(seen) != 1 {
+		t.Errorf("d = %+v after requests %v", r, seen)
+	}
+}
@@ -0,0 +1,141 @@
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// previousRef is the step reference meaning "the line right above"
+const previousRef = "previous"
+
+// ${<id>.response.<field>.<field>} or ${previous.response.<field>}
+// numeric segments index into arrays, e.g. ${list.response.content.0.id}
+var refPattern = regexp.MustCompile(`\$\{([A-Za-z0-9_\-]+)\.response(?:\.([^}]*))?\}`)
+
+// references lists the step ids a step pulls values from
+func references(s *Step) []string {
+	var refs []string
+	collect := func(str string) {
+		for _, m := range refPattern.FindAllStringSubmatch(str, -1) {
+			refs = append(refs, m[1])
+		}
+	}
+	collect(s.Path)
+	walkStrings(s.Params, collect)
+	walkStrings(s.Body, collect)
+	return refs
+}
+
+func walkStrings(v any, fn func(string)) {
+	switch t := v.(type) {
+	case string:
+		fn(t)
+	case map[string]any:
+		for _, e := range t {
+			walkStrings(e, fn)
+		}
+	case []any:
+		for _, e := range t {
+			walkStrings(e, fn)
+		}
+	}
+}
+
+// responses maps a step reference to its decoded response body
+type responses func(ref string) (any, bool)
+
+// substitute replaces ${...} references inside v
+// a string that is exactly one reference takes the referenced value with its
+// JSON type, references embedded in longer strings are formatted as text
+func substitute(v any, lookup responses) (any, error) {
+	switch t := v.(type) {
+	case string:
+		return substituteString(t, lookup)
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, e := range t {
+			r, err := substitute(e, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = r
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(t))
+		for i, e := range t {
+			r, err := substitute(e, lookup)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = r
+		}
+		return out, nil
+	}
+	return v, nil
+}
+
+func substituteString(s string, lookup responses) (any, error) {
+	if m := refPattern.FindStringSubmatch(s); m != nil && m[0] == s {
+		return resolve(m[1], m[2], lookup)
+	}
+	var firstErr error
+	out := refPattern.ReplaceAllStringFunc(s, func(match string) string {
+		m := refPattern.FindStringSubmatch(match)
+		v, err := resolve(m[1], m[2], lookup)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return match
+		}
+		return formatValue(v)
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return out, nil
+}
+
+func resolve(ref, field string, lookup responses) (any, error) {
+	v, ok := lookup(ref)
+	if !ok {
+		return nil, fmt.Errorf("no response recorded for %q", ref)
+	}
+	if field == "" {
+		return v, nil
+	}
+	for _, seg := range strings.Split(field, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			e, ok := t[seg]
+			if !ok {
+				return nil, fmt.Errorf("%s.response.%s: no field %q", ref, field, seg)
+			}
+			v = e
+		case []any:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil, fmt.Errorf("%s.response.%s: bad index %q", ref, field, seg)
+			}
+			v = t[i]
+		default:
+			return nil, fmt.Errorf("%s.response.%s: cannot descend into %q", ref, field, seg)
+		}
+	}
+	return v, nil
+}
+
+func formatValue(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case nil:
+		return ""
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
@@ -0,0 +1,74 @@
+package batch
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSubstitute(t *testing.T) {
+	recorded := map[string]any{}
+	if err := json.Unmarshal([]byte(`{
+		"proj": {"id": "p1", "count": 2, "tags": [{"key": "env"}], "content": [{"id": "z1"}, {"id": "z2"}]},
+		"previous": {"id": "m1"}
+	}`), &recorded); err != nil {
+		t.Fatal(err)
+	}
+	lookup := func(ref string) (any, bool) {
+		v, ok := recorded[ref]
+		return v, ok
+	}
+
+	for _, tt := range []struct {
+		in   any
+		want any
+	}{
+		{"${proj.response.id}", "p1"},
+		// a whole string reference keeps the JSON type
+		{"${proj.response.count}", float64(2)},
+		{"${proj.response.tags}", []any{map[string]any{"key": "env"}}},
+		{"zone ${proj.response.content.1.id} of ${proj.response.count}", "zone z2 of 2"},
+		{"${previous.response.id}", "m1"},
+		{map[string]any{"a": []any{"${proj.response.id}", 3}}, map[string]any{"a": []any{"p1", 3}}},
+		{"no refs", "no refs"},
+		{true, true},
+	} {
+		got, err := substitute(tt.in, lookup)
+		if err != nil {
+			t.Errorf("substitute(%v): %v", tt.in, err)
+		} else if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("substitute(%v) = %#v, want %#v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{
+		"${other.response.id}",
+		"${proj.response.name}",
+		"${proj.response.content.5.id}",
+		"${proj.response.id.x}",
+		"x-${proj.response.missing}",
+	} {
+		if _, err := substitute(in, lookup); err == nil {
+			t.Errorf("substitute(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestBindParams(t *testing.T) {
+	path, query, err := bindParams("/iaas/api/machines/{id}/disks/{diskId}", map[string]any{
+		"id": "m 1", "diskId": float64(7), "apiVersion": "2021-07-15", "expand": []any{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/iaas/api/machines/m%201/disks/7"; path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+	if want := (url.Values{"apiVersion": {"2021-07-15"}, "expand": {"a", "b"}}); !reflect.DeepEqual(query, want) {
+		t.Errorf("query = %v, want %v", query, want)
+	}
+	if _, _, err := bindParams("/machines/{id}", nil); err == nil {
+		t.Error("a missing path parameter was accepted")
+	}
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"iv/pkg/config"
+)
+
+// package cache keeps short lived JSON blobs on disk under ~/.iv/cache
+// it is best effort, every failure is a miss
+
+// Dir returns the cache directory for a namespace (e.g. completion/<profile>)
+func Dir(namespace string) (string, error) {
+	base, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "cache", namespace), nil
+}
+
+// Get decodes the entry into v when it exists and is younger than ttl
+func Get(namespace, key string, ttl time.Duration, v any) bool {
+	dir, err := Dir(namespace)
+	if err != nil {
+		return false
+	}
+	path := filepath.Join(dir, key+".json")
+	fi, err := os.Stat(path)
+	if err != nil || time.Since(fi.ModTime()) > ttl {
+		return false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// Put stores v, writing to a temp file first so readers never see half an entry
+func Put(namespace, key string, v any) error {
+	dir, err := Dir(namespace)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, key+".json"))
+}
+
+// Delete drops an entry
+func Delete(namespace, key string) {
+	if dir, err := Dir(namespace); err == nil {
+		os.Remove(filepath.Join(dir, key+".json"))
+	}
+}
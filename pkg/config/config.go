@@ -0,0 +1,123 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// package config holds the vRA connection profiles used by the iv commands
+// profiles live in ~/.iv/config.json, one entry per vRA instance:
+//
+//	{
+//	  "current": "lab",
+//	  "profiles": {
+//	    "lab": {"server": "https://vra.corp.local", "refreshToken": "..."}
+//	  }
+//	}
+
+const (
+	configDirName  = ".iv"
+	configFileName = "config.json"
+)
+
+// Profile is a single vRA endpoint and the credentials to reach it
+type Profile struct {
+	Name         string `json:"-"`
+	Server       string `json:"server"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+	AccessToken  string `json:"accessToken,omitempty"`
+	Insecure     bool   `json:"insecure,omitempty"`
+}
+
+// Monitor configures the cloud account health checks run by the server mode
+//
+//	"monitor": {
+//	  "profile": "lab",
+//	  "schedule": "*/15 * * * *",
+//	  "accounts": ["vc01", "aws-prod"],
+//	  "webhook": "https://hooks.corp.local/vra"
+//	}
+//
+// no accounts means every cloud account of the profile
+type Monitor struct {
+	Profile  string   `json:"profile,omitempty"`
+	Schedule string   `json:"schedule"`
+	Accounts []string `json:"accounts,omitempty"`
+	Webhook  string   `json:"webhook,omitempty"`
+}
+
+// Config is the on-disk representation of all profiles
+type Config struct {
+	Current  string              `json:"current"`
+	Profiles map[string]*Profile `json:"profiles"`
+	Monitor  *Monitor            `json:"monitor,omitempty"`
+}
+
+// Dir returns the iv config directory, IV_HOME wins over ~/.iv
+func Dir() (string, error) {
+	if d := os.Getenv("IV_HOME"); d != "" {
+		return d, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, configDirName), nil
+}
+
+// Load reads the config file, a missing file is an empty config
+func Load() (*Config, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{Profiles: map[string]*Profile{}}
+	data, err := os.ReadFile(filepath.Join(dir, configFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", configFileName, err)
+	}
+	for name, p := range cfg.Profiles {
+		p.Name = name
+	}
+	return cfg, nil
+}
+
+// Active picks the profile to use: the explicit name, then IV_PROFILE,
+// then the current profile from the file
+// IV_SERVER and IV_REFRESH_TOKEN can stand in for a profile entirely (CI)
+func (c *Config) Active(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv("IV_PROFILE")
+	}
+	if name == "" {
+		name = c.Current
+	}
+	if name == "" {
+		if server := os.Getenv("IV_SERVER"); server != "" {
+			return &Profile{
+				Name:         "env",
+				Server:       server,
+				RefreshToken: os.Getenv("IV_REFRESH_TOKEN"),
+				AccessToken:  os.Getenv("IV_ACCESS_TOKEN"),
+			}, nil
+		}
+		return nil, errors.New("no vRA profile selected, use --profile or set current in config")
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+	if p.Server == "" {
+		return nil, fmt.Errorf("profile %q has no server", name)
+	}
+	return p, nil
+}
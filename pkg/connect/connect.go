@@ -0,0 +1,52 @@
+package connect
+
+import (
+	"context"
+
+	"iv/pkg/apiversion"
+	"iv/pkg/config"
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/endpoints/vra/projects"
+	"iv/pkg/rest"
+)
+
+// package connect turns a profile into an authenticated rest client, for the
+// commands and the server alike
+
+// Client returns a client for the server of p. The stored access token is
+// used and the refresh token only exchanged without one, unless fresh asks
+// for a new access token on every call, as the server does since a stored
+// token expires while it runs. Requests to the IaaS API and the Project
+// Service carry the negotiated apiVersion, warn is told about deprecated
+// versions.
+func Client(ctx context.Context, p *config.Profile, fresh bool, warn func(msg string)) (*rest.Client, error) {
+	var opts []rest.ClientOption
+	if p.Insecure {
+		opts = append(opts, rest.WithInsecureSkipVerify())
+	}
+	token := p.AccessToken
+	if p.RefreshToken != "" && (fresh || token == "") {
+		anon, err := rest.NewClient(p.Server, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if token, err = iaas.Login(ctx, anon, p.RefreshToken); err != nil {
+			return nil, err
+		}
+	}
+	if token != "" {
+		opts = append(opts, rest.WithBearerToken(token))
+	}
+	c, err := rest.NewClient(p.Server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	n := &apiversion.Negotiator{
+		Client:    c,
+		Namespace: "apiversion/" + p.Name,
+		Services:  []apiversion.Service{iaas.APIService, projects.APIService},
+		Warn:      warn,
+	}
+	c.RequestEditors = append(c.RequestEditors, n.Edit)
+	return c, nil
+}
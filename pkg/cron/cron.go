@@ -0,0 +1,150 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// package cron parses the schedules of the server mode jobs: the five
+// standard fields (minute hour day-of-month month day-of-week) with *, lists,
+// ranges and steps, the @hourly style shorthands and @every <duration>
+
+// Schedule returns the next activation after a time
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// every runs at a fixed interval
+type every time.Duration
+
+func (e every) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(e))
+}
+
+// fields is a parsed five field expression, each set holds the allowed values
+type fields struct {
+	minute, hour, dom, month, dow map[int]bool
+	// domAny and dowAny are set for a * day field, the other one alone decides
+	domAny, dowAny bool
+}
+
+var shorthands = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// Parse reads a schedule
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if d, ok := strings.CutPrefix(spec, "@every "); ok {
+		dur, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		}
+		if dur < time.Second {
+			return nil, fmt.Errorf("schedule %q: interval below one second", spec)
+		}
+		return every(dur), nil
+	}
+	if s, ok := shorthands[spec]; ok {
+		spec = s
+	}
+	parts := strings.Fields(spec)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("schedule %q: want 5 fields (minute hour day month weekday) or @every <duration>", spec)
+	}
+	f := &fields{domAny: parts[2] == "*", dowAny: parts[4] == "*"}
+	var err error
+	for i, p := range []struct {
+		set      *map[int]bool
+		min, max int
+	}{{&f.minute, 0, 59}, {&f.hour, 0, 23}, {&f.dom, 1, 31}, {&f.month, 1, 12}, {&f.dow, 0, 7}} {
+		if *p.set, err = parseField(parts[i], p.min, p.max); err != nil {
+			return nil, fmt.Errorf("schedule %q: %w", spec, err)
+		}
+	}
+	// 7 is sunday as well
+	if f.dow[7] {
+		f.dow[0] = true
+	}
+	return f, nil
+}
+
+// parseField reads one comma separated field: *, n, a-b, each with /step
+func parseField(s string, min, max int) (map[int]bool, error) {
+	set := map[int]bool{}
+	for _, part := range strings.Split(s, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step < 1 {
+				return nil, fmt.Errorf("bad step in %q", part)
+			}
+		}
+		lo, hi := min, max
+		if rng != "*" {
+			from, to, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = strconv.Atoi(from); err != nil {
+				return nil, fmt.Errorf("bad value %q", part)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(to); err != nil {
+					return nil, fmt.Errorf("bad range %q", part)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return nil, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			set[v] = true
+		}
+	}
+	return set, nil
+}
+
+func (f *fields) day(t time.Time) bool {
+	dom, dow := f.dom[t.Day()], f.dow[int(t.Weekday())]
+	switch {
+	case f.domAny && f.dowAny:
+		return true
+	case f.domAny:
+		return dow
+	case f.dowAny:
+		return dom
+	}
+	// both restricted, either one matches as in cron
+	return dom || dow
+}
+
+// Next walks forward minute by minute, skipping whole days and hours that
+// cannot match, a schedule that never matches (Feb 31) gives the zero time
+func (f *fields) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !f.month[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !f.day(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !f.hour[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !f.minute[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,68 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+const layout = "2006-01-02 15:04 Mon"
+
+func TestNext(t *testing.T) {
+	// spec, now, next; an empty next is a schedule that never fires
+	cases := [][3]string{
+		{"* * * * *", "2024-01-10 10:30 Wed", "2024-01-10 10:31 Wed"},
+		{"*/15 * * * *", "2024-01-10 10:30 Wed", "2024-01-10 10:45 Wed"},
+		{"0 * * * *", "2024-01-10 23:59 Wed", "2024-01-11 00:00 Thu"},
+		{"30 10 * * *", "2024-01-10 10:30 Wed", "2024-01-11 10:30 Thu"},
+		{"0 9-17/4 * * *", "2024-01-10 10:30 Wed", "2024-01-10 13:00 Wed"},
+		{"0 0 1 * *", "2024-12-10 10:30 Tue", "2025-01-01 00:00 Wed"},
+		{"0 0 * * 0", "2024-01-10 10:30 Wed", "2024-01-14 00:00 Sun"},
+		{"0 0 * * 7", "2024-01-10 10:30 Wed", "2024-01-14 00:00 Sun"},
+		{"0 0 * * 1-5", "2024-01-12 10:30 Fri", "2024-01-15 00:00 Mon"},
+		{"0 0 29 2 *", "2024-03-01 00:00 Fri", "2028-02-29 00:00 Tue"},
+		// both day fields restricted, either one fires
+		{"0 0 20 * 5", "2024-01-10 10:30 Wed", "2024-01-12 00:00 Fri"},
+		{"0 0 20 * 5", "2024-01-19 10:30 Fri", "2024-01-20 00:00 Sat"},
+		{"@daily", "2024-01-10 10:30 Wed", "2024-01-11 00:00 Thu"},
+		{"@every 90m", "2024-01-10 10:30 Wed", "2024-01-10 12:00 Wed"},
+		{"0 0 31 2 *", "2024-01-10 10:30 Wed", ""},
+	}
+	for _, c := range cases {
+		s, err := Parse(c[0])
+		if err != nil {
+			t.Errorf("Parse(%q): %v", c[0], err)
+			continue
+		}
+		now, err := time.Parse(layout, c[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := ""
+		if next := s.Next(now); !next.IsZero() {
+			got = next.Format(layout)
+		}
+		if got != c[2] {
+			t.Errorf("%q from %s: Next() = %q, want %q", c[0], c[1], got, c[2])
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every 10ms",
+		"@every soon",
+	} {
+		if _, err := Parse(spec); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", spec)
+		}
+	}
+}
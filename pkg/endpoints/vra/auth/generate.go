@@ -0,0 +1,10 @@
+package vra8
+
+// The types and the client are generated from the vRA 8 auth spec. The
+// overlay renames the SearchGroupsResponse and SearchUsersResponse schemas,
+// whose type names otherwise collide with the response types of the
+// searchGroups and searchUsers operations. test/spec keeps a copy of the
+// generated files.
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config oapi-types.yaml ../../../../test/spec/vra8_auth_spec.json
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@v2.5.0 -config oapi-client.yaml ../../../../test/spec/vra8_auth_spec.json
@@ -0,0 +1,203 @@
+package iaas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"iv/pkg/apiversion"
+	"iv/pkg/rest"
+)
+
+const (
+	apiPrefix = "/iaas/api"
+	// pageSize is the $top used when walking collections
+	pageSize = 200
+)
+
+// apiVersion is the IaaS API version the client is written against, sent as
+// is on the calls that require one whatever the negotiation picked
+const apiVersion = "2021-07-15"
+
+// APIService is the IaaS API for apiVersion negotiation
+var APIService = apiversion.Service{Name: "iaas", Prefix: apiPrefix, Supported: []string{apiVersion}}
+
+// Client is a typed view of the IaaS API
+type Client struct {
+	rest *rest.Client
+}
+
+// New wraps an authenticated rest client
+func New(c *rest.Client) *Client {
+	return &Client{rest: c}
+}
+
+// REST exposes the underlying client for calls that have no typed helper
+func (c *Client) REST() *rest.Client {
+	return c.rest
+}
+
+// ListOptions narrows collection queries
+type ListOptions struct {
+	// Filter is an OData $filter expression, e.g. name eq 'web-01'
+	Filter string
+	// Limit stops paging once this many items are collected, 0 means all
+	Limit int
+
+	// pinned sends apiVersion for collections that require it
+	pinned bool
+}
+
+func (o *ListOptions) query() url.Values {
+	q := url.Values{}
+	if o != nil && o.Filter != "" {
+		q.Set("$filter", o.Filter)
+	}
+	if o != nil && o.pinned {
+		q.Set("apiVersion", apiVersion)
+	}
+	return q
+}
+
+// pinnedVersion is the query of calls the spec requires apiVersion on
+func pinnedVersion() url.Values {
+	return url.Values{"apiVersion": {apiVersion}}
+}
+
+// page is the envelope IaaS collections are returned in
+type page[T any] struct {
+	Content          []T `json:"content"`
+	TotalElements    int `json:"totalElements"`
+	NumberOfElements int `json:"numberOfElements"`
+}
+
+// list walks a collection with $top/$skip until it is exhausted
+func list[T any](ctx context.Context, c *Client, path string, opts *ListOptions) ([]T, error) {
+	var all []T
+	q := opts.query()
+	for skip := 0; ; {
+		q.Set("$top", strconv.Itoa(pageSize))
+		q.Set("$skip", strconv.Itoa(skip))
+		var p page[T]
+		if err := c.rest.Get(ctx, apiPrefix+path, q, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Content...)
+		skip += len(p.Content)
+		if opts != nil && opts.Limit > 0 && len(all) >= opts.Limit {
+			return all[:opts.Limit], nil
+		}
+		if len(p.Content) < pageSize || (p.TotalElements > 0 && skip >= p.TotalElements) {
+			return all, nil
+		}
+	}
+}
+
+// ErrIncomplete is returned by complete when a collection answers with fewer
+// entities than its totalElements
+var ErrIncomplete = errors.New("collection listing is incomplete")
+
+// complete reads a whole collection and fails rather than return part of it;
+// paged collections are walked with $top/$skip until totalElements, the others
+// are read in one request
+func complete[T any](ctx context.Context, c *Client, path string, paged bool) ([]T, error) {
+	var all []T
+	total := 0
+	if paged {
+		q := url.Values{}
+		for skip := 0; ; {
+			q.Set("$top", strconv.Itoa(pageSize))
+			q.Set("$skip", strconv.Itoa(skip))
+			var p page[T]
+			if err := c.rest.Get(ctx, apiPrefix+path, q, &p); err != nil {
+				return nil, err
+			}
+			all = append(all, p.Content...)
+			skip += len(p.Content)
+			total = p.TotalElements
+			// a server capping pages below $top is walked on by totalElements
+			if len(p.Content) == 0 || (total > 0 && skip >= total) || (total == 0 && len(p.Content) < pageSize) {
+				break
+			}
+		}
+	} else {
+		var raw json.RawMessage
+		if err := c.rest.Get(ctx, apiPrefix+path, nil, &raw); err != nil {
+			return nil, err
+		}
+		if raw = bytes.TrimSpace(raw); len(raw) > 0 && raw[0] != '[' {
+			var p page[T]
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return nil, err
+			}
+			all, total = p.Content, p.TotalElements
+		} else if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &all); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(all) < total {
+		return nil, fmt.Errorf("%s: %w, got %d of %d", path, ErrIncomplete, len(all), total)
+	}
+	return all, nil
+}
+
+func get[T any](ctx context.Context, c *Client, path string) (*T, error) {
+	var v T
+	if err := c.rest.Get(ctx, apiPrefix+path, nil, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// items gets a collection that is not paged, some of these answer with a bare
+// array and others with a single page depending on the release
+func items[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var raw json.RawMessage
+	if err := c.rest.Get(ctx, apiPrefix+path, nil, &raw); err != nil {
+		return nil, err
+	}
+	return decodeItems[T](raw)
+}
+
+// decodeItems reads a bare array or a single page
+func decodeItems[T any](raw json.RawMessage) ([]T, error) {
+	var all []T
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil, nil
+	}
+	if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+		err := json.Unmarshal(raw, &all)
+		return all, err
+	}
+	var p page[T]
+	err := json.Unmarshal(raw, &p)
+	return p.Content, err
+}
+
+// find gets an entity of a collection by id and falls back to looking it up
+// by name (ids that are not UUIDs get a 400 on some endpoints), kind names the
+// entity in errors
+func find[T any](ctx context.Context, c *Client, collection, ref, kind string) (*T, error) {
+	v, err := get[T](ctx, c, collection+"/"+url.PathEscape(ref))
+	if code := rest.StatusCode(err); err == nil || (code != http.StatusNotFound && code != http.StatusBadRequest) {
+		return v, err
+	}
+	all, err := list[T](ctx, c, collection, &ListOptions{Filter: "name eq " + Quote(ref)})
+	if err != nil {
+		return nil, err
+	}
+	switch len(all) {
+	case 0:
+		return nil, fmt.Errorf("%s %s not found", kind, ref)
+	case 1:
+		return &all[0], nil
+	}
+	return nil, fmt.Errorf("%s name %s is ambiguous, use the id", kind, ref)
+}
@@ -0,0 +1,111 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// CloudAccount is an endpoint vRA provisions to, as returned by the generic
+// /cloud-accounts collection
+type CloudAccount struct {
+	Resource
+	CloudAccountType       string            `json:"cloudAccountType"`
+	CloudAccountProperties map[string]string `json:"cloudAccountProperties,omitempty"`
+	EnabledRegions         []Region          `json:"enabledRegions,omitempty"`
+	CustomProperties       map[string]string `json:"customProperties,omitempty"`
+	Tags                   []Tag             `json:"tags,omitempty"`
+	Healthy                *bool             `json:"healthy,omitempty"`
+	InMaintenanceMode      bool              `json:"inMaintenanceMode,omitempty"`
+}
+
+// Region is a region enabled on a cloud account
+type Region struct {
+	ID               string `json:"id"`
+	Name             string `json:"name,omitempty"`
+	ExternalRegionID string `json:"externalRegionId"`
+	CloudAccountID   string `json:"cloudAccountId,omitempty"`
+}
+
+// RegionSpecification is a provider region that can be enabled on an account
+type RegionSpecification struct {
+	ExternalRegionID string `json:"externalRegionId"`
+	Name             string `json:"name"`
+}
+
+// cloudAccountRegions is the result of a region enumeration
+type cloudAccountRegions struct {
+	ExternalRegions []RegionSpecification `json:"externalRegions"`
+}
+
+// CloudAccountTypes are the account types with their own endpoints, each is
+// created through /cloud-accounts-<type>
+var CloudAccountTypes = []string{"vsphere", "aws", "azure", "gcp", "vmc", "vcf", "nsx-t", "nsx-v", "nsx-alb"}
+
+func cloudAccountPath(id string) string {
+	return "/cloud-accounts/" + url.PathEscape(id)
+}
+
+func (c *Client) ListCloudAccounts(ctx context.Context, opts *ListOptions) ([]CloudAccount, error) {
+	return list[CloudAccount](ctx, c, "/cloud-accounts", opts)
+}
+
+func (c *Client) GetCloudAccount(ctx context.Context, id string) (*CloudAccount, error) {
+	return get[CloudAccount](ctx, c, cloudAccountPath(id))
+}
+
+// FindCloudAccount gets a cloud account by id or name
+func (c *Client) FindCloudAccount(ctx context.Context, ref string) (*CloudAccount, error) {
+	return find[CloudAccount](ctx, c, "/cloud-accounts", ref, "cloud account")
+}
+
+// CreateCloudAccount creates an account of one of the CloudAccountTypes,
+// spec is the type specific specification
+func (c *Client) CreateCloudAccount(ctx context.Context, accountType string, spec any) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, "/cloud-accounts-"+accountType, nil, spec)
+}
+
+func (c *Client) DeleteCloudAccount(ctx context.Context, id string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodDelete, cloudAccountPath(id), nil, nil)
+}
+
+// CheckCloudAccountHealth starts a health check, the result is the healthy
+// field of the account once the request finished
+func (c *Client) CheckCloudAccountHealth(ctx context.Context, id string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, cloudAccountPath(id)+"/health-check", nil, nil)
+}
+
+// EnumerateRegions lists the regions the credentials in spec can see, it
+// waits for the enumeration request and reads its result
+func (c *Client) EnumerateRegions(ctx context.Context, accountType string, spec any) ([]RegionSpecification, error) {
+	t, err := c.track(ctx, http.MethodPost, "/cloud-accounts-"+accountType+"/region-enumeration", nil, spec)
+	if err == nil {
+		t, err = c.Wait(ctx, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := t.ResourceIDs()
+	if len(ids) == 0 {
+		return nil, errors.New("region enumeration finished without a result")
+	}
+	r, err := get[cloudAccountRegions](ctx, c, "/cloud-accounts/region-enumeration/"+url.PathEscape(ids[0]))
+	if err != nil {
+		return nil, err
+	}
+	return r.ExternalRegions, nil
+}
+
+// CheckHealth runs a health check on an account, waits for it and returns
+// the account with its updated healthy field
+func (c *Client) CheckHealth(ctx context.Context, id string) (*CloudAccount, error) {
+	t, err := c.CheckCloudAccountHealth(ctx, id)
+	if err == nil {
+		_, err = c.Wait(ctx, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c.GetCloudAccount(ctx, id)
+}
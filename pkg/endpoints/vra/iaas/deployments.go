@@ -0,0 +1,48 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Deployment groups the resources of one request
+type Deployment struct {
+	Resource
+	ProjectID string `json:"projectId,omitempty"`
+}
+
+// Created parses CreatedAt, the zero time when it is missing or malformed
+func (d *Deployment) Created() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, d.CreatedAt)
+	return t
+}
+
+// DeploymentCollections are the collections whose resources belong to a
+// deployment through their deploymentId
+var DeploymentCollections = []string{"/machines", "/networks", "/block-devices", "/load-balancers"}
+
+func (c *Client) ListDeployments(ctx context.Context, opts *ListOptions) ([]Deployment, error) {
+	return list[Deployment](ctx, c, "/deployments", opts)
+}
+
+func (c *Client) GetDeployment(ctx context.Context, id string) (*Deployment, error) {
+	return get[Deployment](ctx, c, "/deployments/"+url.PathEscape(id))
+}
+
+// FindDeployment gets a deployment by id or name
+func (c *Client) FindDeployment(ctx context.Context, ref string) (*Deployment, error) {
+	return find[Deployment](ctx, c, "/deployments", ref, "deployment")
+}
+
+// DeleteDeployment deletes a deployment and its resources, force removes it
+// from vRA even when deleting the resources fails
+func (c *Client) DeleteDeployment(ctx context.Context, id string, force bool) (*RequestTracker, error) {
+	var q url.Values
+	if force {
+		q = url.Values{"forceDelete": {strconv.FormatBool(true)}}
+	}
+	return c.track(ctx, http.MethodDelete, "/deployments/"+url.PathEscape(id), q, nil)
+}
@@ -0,0 +1,173 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// BlockDevice is a disk, attached to a machine or not
+type BlockDevice struct {
+	Resource
+	ExternalID         string            `json:"externalId,omitempty"`
+	ProjectID          string            `json:"projectId,omitempty"`
+	ExternalZoneID     string            `json:"externalZoneId,omitempty"`
+	ExternalRegionID   string            `json:"externalRegionId,omitempty"`
+	CloudAccountIDs    []string          `json:"cloudAccountIds,omitempty"`
+	DeploymentID       string            `json:"deploymentId,omitempty"`
+	ProvisioningStatus string            `json:"provisioningStatus,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+	CustomProperties   map[string]string `json:"customProperties,omitempty"`
+	CapacityInGB       int               `json:"capacityInGB"`
+	Status             string            `json:"status,omitempty"`
+	Type               string            `json:"type,omitempty"`
+	Persistent         bool              `json:"persistent,omitempty"`
+}
+
+// BlockDeviceSpecification describes a disk to create
+type BlockDeviceSpecification struct {
+	Name             string            `json:"name"`
+	ProjectID        string            `json:"projectId"`
+	CapacityInGB     int               `json:"capacityInGB"`
+	Description      string            `json:"description,omitempty"`
+	DeploymentID     string            `json:"deploymentId,omitempty"`
+	Encrypted        bool              `json:"encrypted,omitempty"`
+	Persistent       bool              `json:"persistent,omitempty"`
+	SourceReference  string            `json:"sourceReference,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+	Tags             []Tag             `json:"tags,omitempty"`
+}
+
+// DiskAttachmentSpecification attaches an existing disk to a machine
+type DiskAttachmentSpecification struct {
+	BlockDeviceID            string            `json:"blockDeviceId"`
+	Name                     string            `json:"name,omitempty"`
+	Description              string            `json:"description,omitempty"`
+	DiskAttachmentProperties map[string]string `json:"diskAttachmentProperties,omitempty"`
+}
+
+// DiskSnapshot is a snapshot of a first class disk
+type DiskSnapshot struct {
+	Resource
+	Desc               string            `json:"desc,omitempty"`
+	SnapshotProperties map[string]string `json:"snapshotProperties,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+}
+
+// DiskSnapshotSpecification describes a disk snapshot to take
+type DiskSnapshotSpecification struct {
+	Name               string            `json:"name,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	SnapshotProperties map[string]string `json:"snapshotProperties,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+}
+
+func blockDevicePath(id string) string {
+	return "/block-devices/" + url.PathEscape(id)
+}
+
+func (c *Client) ListBlockDevices(ctx context.Context, opts *ListOptions) ([]BlockDevice, error) {
+	return list[BlockDevice](ctx, c, "/block-devices", opts)
+}
+
+func (c *Client) GetBlockDevice(ctx context.Context, id string) (*BlockDevice, error) {
+	return get[BlockDevice](ctx, c, blockDevicePath(id))
+}
+
+func (c *Client) CreateBlockDevice(ctx context.Context, spec BlockDeviceSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, "/block-devices", nil, spec)
+}
+
+// ResizeBlockDevice changes the capacity of a disk
+func (c *Client) ResizeBlockDevice(ctx context.Context, id string, capacityInGB int) (*RequestTracker, error) {
+	q := url.Values{"capacityInGB": {strconv.Itoa(capacityInGB)}}
+	return c.track(ctx, http.MethodPost, blockDevicePath(id), q, nil)
+}
+
+// DeleteBlockDevice deletes a disk, purge also removes it from the datastore
+func (c *Client) DeleteBlockDevice(ctx context.Context, id string, purge bool) (*RequestTracker, error) {
+	var q url.Values
+	if purge {
+		q = url.Values{"purge": {"true"}}
+	}
+	return c.track(ctx, http.MethodDelete, blockDevicePath(id), q, nil)
+}
+
+func (c *Client) ListMachineDisks(ctx context.Context, machineID string) ([]BlockDevice, error) {
+	return list[BlockDevice](ctx, c, machinePath(machineID)+"/disks", nil)
+}
+
+func (c *Client) AttachMachineDisk(ctx context.Context, machineID string, spec DiskAttachmentSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, machinePath(machineID)+"/disks", nil, spec)
+}
+
+func (c *Client) DetachMachineDisk(ctx context.Context, machineID, diskID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodDelete, machinePath(machineID)+"/disks/"+url.PathEscape(diskID), nil, nil)
+}
+
+func (c *Client) ListDiskSnapshots(ctx context.Context, diskID string) ([]DiskSnapshot, error) {
+	return items[DiskSnapshot](ctx, c, blockDevicePath(diskID)+"/snapshots")
+}
+
+func (c *Client) CreateDiskSnapshot(ctx context.Context, diskID string, spec DiskSnapshotSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, blockDevicePath(diskID)+"/operations/snapshots", nil, spec)
+}
+
+func (c *Client) DeleteDiskSnapshot(ctx context.Context, diskID, snapshotID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodDelete, blockDevicePath(diskID)+"/snapshots/"+url.PathEscape(snapshotID), nil, nil)
+}
+
+// RevertDisk reverts a disk to one of its snapshots
+func (c *Client) RevertDisk(ctx context.Context, diskID, snapshotID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, blockDevicePath(diskID)+"/operations/revert", url.Values{"id": {snapshotID}}, nil)
+}
+
+// PromoteDisk consolidates a disk created from a linked clone
+func (c *Client) PromoteDisk(ctx context.Context, diskID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, blockDevicePath(diskID)+"/operations/promote", nil, nil)
+}
+
+// AttachNewDisk creates a disk in the project and deployment of the machine,
+// waits for it and attaches it, the attached disk is returned; when only the
+// attach fails the error names the created disk, which is left in place
+func (c *Client) AttachNewDisk(ctx context.Context, m *Machine, spec BlockDeviceSpecification) (*BlockDevice, error) {
+	if spec.ProjectID == "" {
+		spec.ProjectID = m.ProjectID
+	}
+	if spec.DeploymentID == "" {
+		spec.DeploymentID = m.DeploymentID
+	}
+	t, err := c.CreateBlockDevice(ctx, spec)
+	if err == nil {
+		t, err = c.Wait(ctx, t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	ids := t.ResourceIDs()
+	if len(ids) == 0 {
+		return nil, errors.New("create block device: request finished without a disk")
+	}
+	t, err = c.AttachMachineDisk(ctx, m.ID, DiskAttachmentSpecification{BlockDeviceID: ids[0]})
+	if err == nil {
+		_, err = c.Wait(ctx, t)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("disk %s created but not attached: %w", ids[0], err)
+	}
+	return c.GetBlockDevice(ctx, ids[0])
+}
+
+// track sends a request answered by a request tracker, an empty answer
+// (204) yields a tracker without id which Wait treats as done
+func (c *Client) track(ctx context.Context, method, path string, q url.Values, body any) (*RequestTracker, error) {
+	var t RequestTracker
+	_, err := c.rest.Do(ctx, method, apiPrefix+path, q, body, &t)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,31 @@
+package iaas
+
+import (
+	"context"
+	"time"
+)
+
+// EventLog is an entry of the IaaS event log
+type EventLog struct {
+	ID           string `json:"id"`
+	EventLogType string `json:"eventLogType,omitempty"`
+	Description  string `json:"description,omitempty"`
+	UpdatedAt    string `json:"updatedAt,omitempty"`
+	Owner        string `json:"owner,omitempty"`
+	OrgID        string `json:"orgId,omitempty"`
+}
+
+// Time parses UpdatedAt, the zero time when it is missing or malformed
+func (e *EventLog) Time() time.Time {
+	t, _ := time.Parse(time.RFC3339Nano, e.UpdatedAt)
+	return t
+}
+
+func (c *Client) ListEventLogs(ctx context.Context, opts *ListOptions) ([]EventLog, error) {
+	return list[EventLog](ctx, c, "/event-logs", opts)
+}
+
+// TimeLiteral formats a time for $filter comparisons on date fields
+func TimeLiteral(t time.Time) string {
+	return Quote(t.UTC().Format("2006-01-02T15:04:05.000Z"))
+}
@@ -0,0 +1,62 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+)
+
+// FabricCompute is a compute resource of a cloud account (a vSphere cluster
+// or host, an availability zone) that zones place machines on
+type FabricCompute struct {
+	Resource
+	ExternalID                            string            `json:"externalId,omitempty"`
+	ExternalZoneID                        string            `json:"externalZoneId,omitempty"`
+	ExternalRegionID                      string            `json:"externalRegionId,omitempty"`
+	Tags                                  []Tag             `json:"tags,omitempty"`
+	Type                                  string            `json:"type,omitempty"`
+	LifecycleState                        string            `json:"lifecycleState,omitempty"`
+	PowerState                            string            `json:"powerState,omitempty"`
+	CustomProperties                      map[string]string `json:"customProperties,omitempty"`
+	MaximumAllowedMemoryAllocationPercent int               `json:"maximumAllowedMemoryAllocationPercent,omitempty"`
+	MaximumAllowedCPUAllocationPercent    int               `json:"maximumAllowedCpuAllocationPercent,omitempty"`
+}
+
+// FabricVsphereDatastore is a vSphere datastore, FreeSizeGB is a string in
+// the API
+type FabricVsphereDatastore struct {
+	Resource
+	ExternalID                             string   `json:"externalId,omitempty"`
+	Type                                   string   `json:"type,omitempty"`
+	ExternalRegionID                       string   `json:"externalRegionId,omitempty"`
+	FreeSizeGB                             string   `json:"freeSizeGB,omitempty"`
+	CloudAccountIDs                        []string `json:"cloudAccountIds,omitempty"`
+	Tags                                   []Tag    `json:"tags,omitempty"`
+	MaximumAllowedStorageAllocationPercent int      `json:"maximumAllowedStorageAllocationPercent,omitempty"`
+	AllocatedNonDiskStorageSpaceBytes      int64    `json:"allocatedNonDiskStorageSpaceBytes,omitempty"`
+}
+
+// FabricVsphereStoragePolicy is a vSphere storage policy
+type FabricVsphereStoragePolicy struct {
+	Resource
+	ExternalID       string   `json:"externalId,omitempty"`
+	ExternalRegionID string   `json:"externalRegionId,omitempty"`
+	CloudAccountIDs  []string `json:"cloudAccountIds,omitempty"`
+	Tags             []Tag    `json:"tags,omitempty"`
+}
+
+func (c *Client) ListFabricComputes(ctx context.Context, opts *ListOptions) ([]FabricCompute, error) {
+	return list[FabricCompute](ctx, c, "/fabric-computes", opts)
+}
+
+// ZoneComputes lists the fabric computes a zone places machines on
+func (c *Client) ZoneComputes(ctx context.Context, zoneID string) ([]FabricCompute, error) {
+	return items[FabricCompute](ctx, c, "/zones/"+url.PathEscape(zoneID)+"/computes")
+}
+
+func (c *Client) ListFabricVsphereDatastores(ctx context.Context, opts *ListOptions) ([]FabricVsphereDatastore, error) {
+	return list[FabricVsphereDatastore](ctx, c, "/fabric-vsphere-datastores", opts)
+}
+
+func (c *Client) ListFabricVsphereStoragePolicies(ctx context.Context, opts *ListOptions) ([]FabricVsphereStoragePolicy, error) {
+	return list[FabricVsphereStoragePolicy](ctx, c, "/fabric-vsphere-storage-policies", opts)
+}
@@ -0,0 +1,130 @@
+package iaas
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// NetworkIPRange is an internal IP range, the counters are only filled in
+// when the range is read on its own
+type NetworkIPRange struct {
+	Resource
+	ExternalID                 string `json:"externalId,omitempty"`
+	StartIPAddress             string `json:"startIPAddress"`
+	EndIPAddress               string `json:"endIPAddress"`
+	IPVersion                  string `json:"ipVersion,omitempty"`
+	Tags                       []Tag  `json:"tags,omitempty"`
+	NumberOfAllocatedIPs       int    `json:"numberOfAllocatedIPs,omitempty"`
+	NumberOfAvailableIPs       int    `json:"numberOfAvailableIPs,omitempty"`
+	NumberOfReleasedIPs        int    `json:"numberOfReleasedIPs,omitempty"`
+	TotalNumberOfIPs           int    `json:"totalNumberOfIPs,omitempty"`
+	NumberOfUserAllocatedIPs   int    `json:"numberOfUserAllocatedIPs,omitempty"`
+	NumberOfSystemAllocatedIPs int    `json:"numberOfSystemAllocatedIPs,omitempty"`
+}
+
+// NetworkIPAddress is an allocated or released address of a range
+type NetworkIPAddress struct {
+	Resource
+	IPAddress        string `json:"ipAddress"`
+	IPVersion        string `json:"ipVersion,omitempty"`
+	IPAddressStatus  string `json:"ipAddressStatus,omitempty"`
+	IPAllocationType string `json:"ipAllocationType,omitempty"`
+}
+
+// IPAddressAllocateSpecification asks for specific addresses or for a number
+// of free ones
+type IPAddressAllocateSpecification struct {
+	Description string   `json:"description,omitempty"`
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	NumberOfIPs int      `json:"numberOfIps,omitempty"`
+}
+
+// IPAddressReleaseSpecification lists addresses to release
+type IPAddressReleaseSpecification struct {
+	IPAddresses []string `json:"ipAddresses"`
+}
+
+func ipRangePath(id string) string {
+	return "/network-ip-ranges/" + url.PathEscape(id)
+}
+
+func (c *Client) ListNetworkIPRanges(ctx context.Context, opts *ListOptions) ([]NetworkIPRange, error) {
+	return list[NetworkIPRange](ctx, c, "/network-ip-ranges", opts)
+}
+
+func (c *Client) GetNetworkIPRange(ctx context.Context, id string) (*NetworkIPRange, error) {
+	return get[NetworkIPRange](ctx, c, ipRangePath(id))
+}
+
+// FindNetworkIPRange accepts a range id or name
+func (c *Client) FindNetworkIPRange(ctx context.Context, ref string) (*NetworkIPRange, error) {
+	return find[NetworkIPRange](ctx, c, "/network-ip-ranges", ref, "ip range")
+}
+
+// the addresses of a range require apiVersion, the calls send the client's
+// own instead of relying on negotiation
+
+func (c *Client) ListIPAddresses(ctx context.Context, rangeID string) ([]NetworkIPAddress, error) {
+	return list[NetworkIPAddress](ctx, c, ipRangePath(rangeID)+"/ip-addresses", &ListOptions{pinned: true})
+}
+
+func (c *Client) GetIPAddress(ctx context.Context, rangeID, addressID string) (*NetworkIPAddress, error) {
+	var a NetworkIPAddress
+	if err := c.rest.Get(ctx, apiPrefix+ipRangePath(rangeID)+"/ip-addresses/"+url.PathEscape(addressID), pinnedVersion(), &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// AllocateIPs reserves addresses in a range for use outside of deployments
+func (c *Client) AllocateIPs(ctx context.Context, rangeID string, spec IPAddressAllocateSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, ipRangePath(rangeID)+"/ip-addresses/allocate", pinnedVersion(), spec)
+}
+
+// ReleaseIPs releases addresses allocated with AllocateIPs
+func (c *Client) ReleaseIPs(ctx context.Context, rangeID string, ips []string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, ipRangePath(rangeID)+"/ip-addresses/release", nil, IPAddressReleaseSpecification{IPAddresses: ips})
+}
+
+// ReleaseUnregisteredIPs releases addresses vRA holds for machines that no
+// longer exist
+func (c *Client) ReleaseUnregisteredIPs(ctx context.Context, rangeID string, ips []string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, ipRangePath(rangeID)+"/unregistered-ip-addresses/release", nil, IPAddressReleaseSpecification{IPAddresses: ips})
+}
+
+// packageImportPath is the tus collection for IPAM integration packages
+const packageImportPath = "/integrations-ipam/package-import"
+
+// PackageImport describes an imported IPAM provider package
+type PackageImport struct {
+	ProviderID      string `json:"providerId,omitempty"`
+	ProviderName    string `json:"providerName,omitempty"`
+	ProviderVersion string `json:"providerVersion,omitempty"`
+	BundleID        string `json:"bundleId,omitempty"`
+	IntegrationType string `json:"integrationType,omitempty"`
+	Message         string `json:"message,omitempty"`
+}
+
+// ImportIPAMPackage uploads a provider bundle with tus, resuming u.URL when
+// set, and then finalizes the import, option is passed through (e.g. to
+// overwrite an existing provider)
+func (c *Client) ImportIPAMPackage(ctx context.Context, u *Upload, r io.ReaderAt, option string) (*PackageImport, error) {
+	if err := c.Upload(ctx, packageImportPath, u, r); err != nil {
+		return nil, err
+	}
+	body := map[string]string{"bundleId": u.ID()}
+	if option != "" {
+		body["option"] = option
+	}
+	req, err := c.tusRequest(ctx, http.MethodPost, apiPrefix+packageImportPath, body)
+	if err != nil {
+		return nil, err
+	}
+	var out PackageImport
+	if _, err := c.rest.Send(req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,42 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"iv/pkg/rest"
+)
+
+func TestIPAddressCallsSendAPIVersion(t *testing.T) {
+	seen := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen[r.Method+" "+r.URL.Path] = r.URL.Query().Get("apiVersion")
+		w.Write([]byte(`{"content":[]}`))
+	}))
+	defer srv.Close()
+	rc, err := rest.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, ctx := New(rc), context.Background()
+	if _, err := c.ListIPAddresses(ctx, "r1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetIPAddress(ctx, "r1", "a1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.AllocateIPs(ctx, "r1", IPAddressAllocateSpecification{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, call := range []string{
+		"GET /iaas/api/network-ip-ranges/r1/ip-addresses",
+		"GET /iaas/api/network-ip-ranges/r1/ip-addresses/a1",
+		"POST /iaas/api/network-ip-ranges/r1/ip-addresses/allocate",
+	} {
+		if v, ok := seen[call]; !ok || v != apiVersion {
+			t.Errorf("%s sent apiVersion %q, want %s", call, v, apiVersion)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// LoadBalancer is a provisioned load balancer
+type LoadBalancer struct {
+	Resource
+	ExternalID         string               `json:"externalId,omitempty"`
+	ProjectID          string               `json:"projectId,omitempty"`
+	ExternalZoneID     string               `json:"externalZoneId,omitempty"`
+	ExternalRegionID   string               `json:"externalRegionId,omitempty"`
+	CloudAccountIDs    []string             `json:"cloudAccountIds,omitempty"`
+	DeploymentID       string               `json:"deploymentId,omitempty"`
+	ProvisioningStatus string               `json:"provisioningStatus,omitempty"`
+	Tags               []Tag                `json:"tags,omitempty"`
+	CustomProperties   map[string]string    `json:"customProperties,omitempty"`
+	Address            string               `json:"address,omitempty"`
+	Type               string               `json:"type,omitempty"`
+	LoggingLevel       string               `json:"loggingLevel,omitempty"`
+	Routes             []RouteConfiguration `json:"routes"`
+}
+
+// RouteConfiguration forwards a port of the load balancer to a port of its
+// members
+type RouteConfiguration struct {
+	Protocol                 string                    `json:"protocol"`
+	Port                     string                    `json:"port"`
+	MemberProtocol           string                    `json:"memberProtocol"`
+	MemberPort               string                    `json:"memberPort"`
+	Algorithm                string                    `json:"algorithm,omitempty"`
+	AlgorithmParameters      string                    `json:"algorithmParameters,omitempty"`
+	HealthCheckConfiguration *HealthCheckConfiguration `json:"healthCheckConfiguration,omitempty"`
+}
+
+// HealthCheckConfiguration says how the members of a route are checked
+type HealthCheckConfiguration struct {
+	Protocol           string `json:"protocol,omitempty"`
+	Port               string `json:"port,omitempty"`
+	URLPath            string `json:"urlPath,omitempty"`
+	IntervalSeconds    int    `json:"intervalSeconds,omitempty"`
+	TimeoutSeconds     int    `json:"timeoutSeconds,omitempty"`
+	UnhealthyThreshold int    `json:"unhealthyThreshold,omitempty"`
+	HealthyThreshold   int    `json:"healthyThreshold,omitempty"`
+	HTTPMethod         string `json:"httpMethod,omitempty"`
+	RequestBody        string `json:"requestBody,omitempty"`
+	ResponseBody       string `json:"responseBody,omitempty"`
+	PassiveMonitor     bool   `json:"passiveMonitor,omitempty"`
+}
+
+// LoadBalancerSpecification describes a load balancer to create, or the
+// desired state of one to scale
+type LoadBalancerSpecification struct {
+	Name             string                          `json:"name"`
+	ProjectID        string                          `json:"projectId"`
+	Description      string                          `json:"description,omitempty"`
+	DeploymentID     string                          `json:"deploymentId,omitempty"`
+	Routes           []RouteConfiguration            `json:"routes"`
+	Nics             []NetworkInterfaceSpecification `json:"nics"`
+	TargetLinks      []string                        `json:"targetLinks,omitempty"`
+	InternetFacing   bool                            `json:"internetFacing,omitempty"`
+	Type             string                          `json:"type,omitempty"`
+	LoggingLevel     string                          `json:"loggingLevel,omitempty"`
+	CustomProperties map[string]string               `json:"customProperties,omitempty"`
+	Tags             []Tag                           `json:"tags,omitempty"`
+}
+
+// Validate checks the routes of a specification before it is sent
+func (s *LoadBalancerSpecification) Validate() error {
+	return ValidateRoutes(s.Routes)
+}
+
+func loadBalancerPath(id string) string {
+	return "/load-balancers/" + url.PathEscape(id)
+}
+
+func (c *Client) ListLoadBalancers(ctx context.Context, opts *ListOptions) ([]LoadBalancer, error) {
+	return list[LoadBalancer](ctx, c, "/load-balancers", opts)
+}
+
+func (c *Client) GetLoadBalancer(ctx context.Context, id string) (*LoadBalancer, error) {
+	return get[LoadBalancer](ctx, c, loadBalancerPath(id))
+}
+
+// FindLoadBalancer accepts a load balancer id or name
+func (c *Client) FindLoadBalancer(ctx context.Context, ref string) (*LoadBalancer, error) {
+	return find[LoadBalancer](ctx, c, "/load-balancers", ref, "load balancer")
+}
+
+func (c *Client) CreateLoadBalancer(ctx context.Context, spec LoadBalancerSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, "/load-balancers", nil, spec)
+}
+
+// ScaleLoadBalancer changes a load balancer to the given specification,
+// typically its routes and target links
+func (c *Client) ScaleLoadBalancer(ctx context.Context, id string, spec LoadBalancerSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, loadBalancerPath(id)+"/operations/scale", nil, spec)
+}
+
+// DeleteLoadBalancer deletes a load balancer with the day 2 operation, force
+// removes it from vRA even when deleting it on the provider fails
+func (c *Client) DeleteLoadBalancer(ctx context.Context, id string, force bool) (*RequestTracker, error) {
+	var q url.Values
+	if force {
+		q = url.Values{"forceDelete": {strconv.FormatBool(true)}}
+	}
+	return c.track(ctx, http.MethodPost, loadBalancerPath(id)+"/operations/delete", q, nil)
+}
+
+// MachineLink is the target link of a machine in a load balancer pool
+func MachineLink(id string) string {
+	return apiPrefix + machinePath(id)
+}
+
+// ScaleSpecification is the current state of a load balancer as a
+// specification to scale it with; network interfaces are read back from its
+// links
+func (c *Client) ScaleSpecification(ctx context.Context, lb *LoadBalancer) (*LoadBalancerSpecification, error) {
+	spec := &LoadBalancerSpecification{
+		Name:             lb.Name,
+		ProjectID:        lb.ProjectID,
+		Description:      lb.Description,
+		DeploymentID:     lb.DeploymentID,
+		Routes:           lb.Routes,
+		Type:             lb.Type,
+		LoggingLevel:     lb.LoggingLevel,
+		CustomProperties: lb.CustomProperties,
+		Tags:             lb.Tags,
+	}
+	for _, id := range linkIDs(lb.Links["network-interfaces"]) {
+		n, err := get[NetworkInterface](ctx, c, loadBalancerPath(lb.ID)+"/network-interfaces/"+url.PathEscape(id))
+		if err != nil {
+			return nil, err
+		}
+		index := n.DeviceIndex
+		nic := NetworkInterfaceSpecification{Name: n.Name, DeviceIndex: &index, SecurityGroupIDs: n.SecurityGroupIDs}
+		if ids := linkIDs(n.Links["network"]); len(ids) > 0 {
+			nic.NetworkID = ids[0]
+		}
+		spec.Nics = append(spec.Nics, nic)
+	}
+	return spec, nil
+}
@@ -0,0 +1,36 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+
+	"iv/pkg/rest"
+)
+
+// package iaas wraps the vRA IaaS API (/iaas/api) on top of the rest client
+
+const loginPath = "/iaas/api/login"
+
+// CspLoginSpecification carries the refresh (API) token from the UI
+type CspLoginSpecification struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// AuthResponse holds the bearer token returned by login
+type AuthResponse struct {
+	TokenType string `json:"tokenType"`
+	Token     string `json:"token"`
+}
+
+// Login exchanges a refresh token for a bearer access token
+func Login(ctx context.Context, c *rest.Client, refreshToken string) (string, error) {
+	var rsp AuthResponse
+	err := c.Post(ctx, loginPath, nil, CspLoginSpecification{RefreshToken: refreshToken}, &rsp)
+	if err != nil {
+		return "", err
+	}
+	if rsp.Token == "" {
+		return "", errors.New("login returned an empty token")
+	}
+	return rsp.Token, nil
+}
@@ -0,0 +1,42 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Machine is a provisioned virtual machine
+type Machine struct {
+	Resource
+	ExternalID         string            `json:"externalId,omitempty"`
+	ProjectID          string            `json:"projectId,omitempty"`
+	ExternalZoneID     string            `json:"externalZoneId,omitempty"`
+	ExternalRegionID   string            `json:"externalRegionId,omitempty"`
+	CloudAccountIDs    []string          `json:"cloudAccountIds,omitempty"`
+	DeploymentID       string            `json:"deploymentId,omitempty"`
+	ProvisioningStatus string            `json:"provisioningStatus,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+	CustomProperties   map[string]string `json:"customProperties,omitempty"`
+	PowerState         string            `json:"powerState,omitempty"`
+	Address            string            `json:"address,omitempty"`
+	Hostname           string            `json:"hostname,omitempty"`
+}
+
+func (c *Client) ListMachines(ctx context.Context, opts *ListOptions) ([]Machine, error) {
+	return list[Machine](ctx, c, "/machines", opts)
+}
+
+func (c *Client) GetMachine(ctx context.Context, id string) (*Machine, error) {
+	return get[Machine](ctx, c, "/machines/"+url.PathEscape(id))
+}
+
+// FindMachine accepts a machine id or name
+func (c *Client) FindMachine(ctx context.Context, ref string) (*Machine, error) {
+	return find[Machine](ctx, c, "/machines", ref, "machine")
+}
+
+// Quote makes s an OData string literal
+func Quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,118 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"iv/pkg/rest"
+)
+
+// CustomNaming is a set of naming templates and the projects they apply to
+type CustomNaming struct {
+	Resource
+	Projects  []NamingProject  `json:"projects,omitempty"`
+	Templates []NamingTemplate `json:"templates,omitempty"`
+}
+
+// NamingProject maps a custom naming to a project, or to the whole org
+type NamingProject struct {
+	ID          string `json:"id,omitempty"`
+	ProjectID   string `json:"projectId,omitempty"`
+	OrgID       string `json:"orgId,omitempty"`
+	ProjectName string `json:"projectName,omitempty"`
+	Active      bool   `json:"active,omitempty"`
+	DefaultOrg  bool   `json:"defaultOrg,omitempty"`
+}
+
+// NamingTemplate is the pattern used for one resource type
+type NamingTemplate struct {
+	ID               string          `json:"id,omitempty"`
+	Name             string          `json:"name,omitempty"`
+	ResourceType     string          `json:"resourceType,omitempty"`
+	ResourceTypeName string          `json:"resourceTypeName,omitempty"`
+	UniqueName       bool            `json:"uniqueName,omitempty"`
+	ResourceDefault  bool            `json:"resourceDefault,omitempty"`
+	StartCounter     int64           `json:"startCounter,omitempty"`
+	IncrementStep    int64           `json:"incrementStep,omitempty"`
+	Pattern          string          `json:"pattern,omitempty"`
+	StaticPattern    string          `json:"staticPattern,omitempty"`
+	Counters         []NamingCounter `json:"counters,omitempty"`
+}
+
+// NamingCounter is the counter of a template within one project
+type NamingCounter struct {
+	ID             string `json:"id,omitempty"`
+	CNResourceType string `json:"cnResourceType"`
+	CurrentCounter int64  `json:"currentCounter"`
+	Active         bool   `json:"active,omitempty"`
+	ProjectID      string `json:"projectId"`
+}
+
+// ListNamingObjects returns every custom naming
+func (c *Client) ListNamingObjects(ctx context.Context) ([]Object, error) {
+	return items[Object](ctx, c, "/naming")
+}
+
+func (c *Client) ListNaming(ctx context.Context) ([]CustomNaming, error) {
+	return items[CustomNaming](ctx, c, "/naming")
+}
+
+func (c *Client) GetNaming(ctx context.Context, id string) (*CustomNaming, error) {
+	return get[CustomNaming](ctx, c, "/naming/"+url.PathEscape(id))
+}
+
+// FindNaming gets a custom naming by id or name, the collection has no
+// $filter so names are matched on the full listing
+func (c *Client) FindNaming(ctx context.Context, ref string) (*CustomNaming, error) {
+	n, err := c.GetNaming(ctx, ref)
+	if code := rest.StatusCode(err); err == nil || (code != http.StatusNotFound && code != http.StatusBadRequest) {
+		return n, err
+	}
+	all, err := c.ListNaming(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var found *CustomNaming
+	for i := range all {
+		if all[i].Name != ref {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("custom naming name %s is ambiguous, use the id", ref)
+		}
+		found = &all[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("custom naming %s not found", ref)
+	}
+	return found, nil
+}
+
+// NamingForProject returns the custom naming that applies to a project
+func (c *Client) NamingForProject(ctx context.Context, projectID string) (*CustomNaming, error) {
+	return get[CustomNaming](ctx, c, "/naming/projectId/"+url.PathEscape(projectID))
+}
+
+func (c *Client) CreateNaming(ctx context.Context, spec *CustomNaming) (*CustomNaming, error) {
+	var n CustomNaming
+	if err := c.rest.Post(ctx, apiPrefix+"/naming", nil, spec, &n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+// UpdateNaming replaces the custom naming with the id of spec, templates
+// not in spec are removed
+func (c *Client) UpdateNaming(ctx context.Context, spec *CustomNaming) (*CustomNaming, error) {
+	var n CustomNaming
+	if _, err := c.rest.Do(ctx, http.MethodPut, apiPrefix+"/naming", nil, spec, &n); err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
+
+func (c *Client) DeleteNaming(ctx context.Context, id string) error {
+	return c.rest.Delete(ctx, apiPrefix+"/naming/"+url.PathEscape(id), nil, nil)
+}
@@ -0,0 +1,68 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+)
+
+// Network is a network provisioned for a deployment
+type Network struct {
+	Resource
+	ExternalID         string            `json:"externalId,omitempty"`
+	ProjectID          string            `json:"projectId,omitempty"`
+	ExternalZoneID     string            `json:"externalZoneId,omitempty"`
+	ExternalRegionID   string            `json:"externalRegionId,omitempty"`
+	CloudAccountIDs    []string          `json:"cloudAccountIds,omitempty"`
+	DeploymentID       string            `json:"deploymentId,omitempty"`
+	ProvisioningStatus string            `json:"provisioningStatus,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+	CustomProperties   map[string]string `json:"customProperties,omitempty"`
+	Cidr               string            `json:"cidr,omitempty"`
+}
+
+// NetworkInterface is a network interface of a machine or load balancer
+type NetworkInterface struct {
+	Resource
+	ExternalID       string            `json:"externalId,omitempty"`
+	DeviceIndex      int               `json:"deviceIndex"`
+	Addresses        []string          `json:"addresses,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIds,omitempty"`
+	Tags             []Tag             `json:"tags,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+}
+
+// NetworkInterfaceSpecification describes a network interface to create or
+// change, either NetworkID or FabricNetworkID is set on creation
+type NetworkInterfaceSpecification struct {
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	DeviceIndex      *int              `json:"deviceIndex,omitempty"`
+	NetworkID        string            `json:"networkId,omitempty"`
+	FabricNetworkID  string            `json:"fabricNetworkId,omitempty"`
+	Addresses        []string          `json:"addresses,omitempty"`
+	MacAddress       string            `json:"macAddress,omitempty"`
+	SecurityGroupIDs []string          `json:"securityGroupIds,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+}
+
+func (c *Client) ListNetworks(ctx context.Context, opts *ListOptions) ([]Network, error) {
+	return list[Network](ctx, c, "/networks", opts)
+}
+
+// FindNetwork accepts a network id or name
+func (c *Client) FindNetwork(ctx context.Context, ref string) (*Network, error) {
+	return find[Network](ctx, c, "/networks", ref, "network")
+}
+
+// MachineNetworkInterfaces reads the network interfaces a machine links to
+func (c *Client) MachineNetworkInterfaces(ctx context.Context, m *Machine) ([]NetworkInterface, error) {
+	var nics []NetworkInterface
+	for _, id := range linkIDs(m.Links["network-interfaces"]) {
+		n, err := get[NetworkInterface](ctx, c, machinePath(m.ID)+"/network-interfaces/"+url.PathEscape(id))
+		if err != nil {
+			return nil, err
+		}
+		nics = append(nics, *n)
+	}
+	return nics, nil
+}
@@ -0,0 +1,118 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Object is an untyped IaaS entity, used where the shape varies by kind
+// (manifests, diffs, exports)
+type Object = map[string]any
+
+// ListObjects walks any IaaS collection, path is relative to /iaas/api
+func (c *Client) ListObjects(ctx context.Context, collection string, opts *ListOptions) ([]Object, error) {
+	return list[Object](ctx, c, collection, opts)
+}
+
+// unpaged are the collections the API does not page, $top and $skip are not
+// parameters of their listing
+var unpaged = map[string]bool{
+	"/networks": true, "/load-balancers": true, "/security-groups": true, "/storage-profiles": true,
+	"/compute-gateways": true, "/compute-nats": true, "/network-domains": true, "/network-ip-ranges": true,
+	"/external-network-ip-ranges": true,
+}
+
+// ListAllObjects reads every entity of a collection and fails with
+// ErrIncomplete when the server counts more than it returned, for callers
+// that act on what is missing from the listing
+func (c *Client) ListAllObjects(ctx context.Context, collection string) ([]Object, error) {
+	return complete[Object](ctx, c, collection, !unpaged[collection])
+}
+
+// GetObject reads one entity of a collection
+func (c *Client) GetObject(ctx context.Context, collection, id string) (Object, error) {
+	obj, err := get[Object](ctx, c, collection+"/"+url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+	return *obj, nil
+}
+
+// CreateObject posts a specification to a collection and returns the result
+func (c *Client) CreateObject(ctx context.Context, collection string, spec any) (Object, error) {
+	var out Object
+	err := c.rest.Post(ctx, apiPrefix+collection, nil, spec, &out)
+	return out, err
+}
+
+// UpdateObject sends a specification to an entity with PATCH or PUT, an empty
+// id sends it to the collection itself (e.g. PUT /naming)
+func (c *Client) UpdateObject(ctx context.Context, method, collection, id string, spec any) (Object, error) {
+	var out Object
+	p := apiPrefix + collection
+	if id != "" {
+		p += "/" + url.PathEscape(id)
+	}
+	_, err := c.rest.Do(ctx, method, p, nil, spec, &out)
+	return out, err
+}
+
+// DeleteObject deletes an entity
+func (c *Client) DeleteObject(ctx context.Context, collection, id string) error {
+	_, err := c.rest.Do(ctx, http.MethodDelete, apiPrefix+collection+"/"+url.PathEscape(id), nil, nil, nil)
+	return err
+}
+
+// LinkID returns the id at the end of a HATEOAS link, e.g. the region of a zone
+func LinkID(obj Object, rel string) string {
+	ids := LinkIDs(obj, rel)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
+
+// LinkIDs returns the ids at the end of every href of a HATEOAS link
+func LinkIDs(obj Object, rel string) []string {
+	links, _ := obj["_links"].(map[string]any)
+	l, _ := links[rel].(map[string]any)
+	var ids []string
+	if href, ok := l["href"].(string); ok && href != "" {
+		ids = append(ids, path.Base(strings.TrimSuffix(href, "/")))
+	}
+	if hrefs, ok := l["hrefs"].([]any); ok {
+		for _, h := range hrefs {
+			if s, ok := h.(string); ok && s != "" {
+				ids = append(ids, path.Base(strings.TrimSuffix(s, "/")))
+			}
+		}
+	}
+	return ids
+}
+
+// linkIDs is LinkIDs for the links of a typed entity
+func linkIDs(l Link) []string {
+	var ids []string
+	for _, h := range append([]string{l.Href}, l.Hrefs...) {
+		if h != "" {
+			ids = append(ids, path.Base(strings.TrimSuffix(h, "/")))
+		}
+	}
+	return ids
+}
+
+// Count returns the number of entities of a collection that match filter,
+// without reading them
+func (c *Client) Count(ctx context.Context, collection, filter string) (int, error) {
+	q := (&ListOptions{Filter: filter}).query()
+	q.Set("$top", "1")
+	q.Set("$count", "true")
+	var p page[Object]
+	if err := c.rest.Get(ctx, apiPrefix+collection, q, &p); err != nil {
+		return 0, err
+	}
+	return p.TotalElements, nil
+}
@@ -0,0 +1,81 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+)
+
+// FlavorProfile maps logical flavor names to the flavors of one region
+type FlavorProfile struct {
+	Resource
+	FlavorMappings   FlavorMapping `json:"flavorMappings"`
+	ExternalRegionID string        `json:"externalRegionId,omitempty"`
+	CloudAccountID   string        `json:"cloudAccountId,omitempty"`
+}
+
+// FlavorMapping is keyed by the logical flavor name
+type FlavorMapping struct {
+	Mapping          map[string]FabricFlavor `json:"mapping"`
+	ExternalRegionID string                  `json:"externalRegionId,omitempty"`
+}
+
+// FabricFlavor is an instance type of a cloud; vSphere flavors are only a
+// size and have neither id nor name
+type FabricFlavor struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// ImageProfile maps logical image names to the images of one region
+type ImageProfile struct {
+	Resource
+	ImageMappings    ImageMapping `json:"imageMappings"`
+	ExternalRegionID string       `json:"externalRegionId,omitempty"`
+	CloudAccountID   string       `json:"cloudAccountId,omitempty"`
+}
+
+// ImageMapping is keyed by the logical image name
+type ImageMapping struct {
+	Mapping          map[string]ImageMappingDescription `json:"mapping"`
+	ExternalRegionID string                             `json:"externalRegionId,omitempty"`
+}
+
+// ImageMappingDescription is the fabric image a logical image maps to
+type ImageMappingDescription struct {
+	ID               string   `json:"id,omitempty"`
+	Name             string   `json:"name,omitempty"`
+	ExternalID       string   `json:"externalId,omitempty"`
+	ExternalRegionID string   `json:"externalRegionId,omitempty"`
+	OSFamily         string   `json:"osFamily,omitempty"`
+	CloudAccountIDs  []string `json:"cloudAccountIds,omitempty"`
+}
+
+// FabricImage is an image discovered on a cloud account
+type FabricImage struct {
+	Resource
+	ExternalID       string   `json:"externalId,omitempty"`
+	OSFamily         string   `json:"osFamily,omitempty"`
+	ExternalRegionID string   `json:"externalRegionId,omitempty"`
+	IsPrivate        bool     `json:"isPrivate,omitempty"`
+	CloudAccountIDs  []string `json:"cloudAccountIds,omitempty"`
+}
+
+func (c *Client) ListFlavorProfiles(ctx context.Context) ([]FlavorProfile, error) {
+	return list[FlavorProfile](ctx, c, "/flavor-profiles", nil)
+}
+
+func (c *Client) ListImageProfiles(ctx context.Context) ([]ImageProfile, error) {
+	return list[ImageProfile](ctx, c, "/image-profiles", nil)
+}
+
+func (c *Client) ListFabricFlavors(ctx context.Context) ([]FabricFlavor, error) {
+	return list[FabricFlavor](ctx, c, "/fabric-flavors", nil)
+}
+
+func (c *Client) GetFabricImage(ctx context.Context, id string) (*FabricImage, error) {
+	return get[FabricImage](ctx, c, "/fabric-images/"+url.PathEscape(id))
+}
+
+func (c *Client) ListFabricImages(ctx context.Context, opts *ListOptions) ([]FabricImage, error) {
+	return list[FabricImage](ctx, c, "/fabric-images", opts)
+}
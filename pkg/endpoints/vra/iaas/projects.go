@@ -0,0 +1,50 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+)
+
+// User is a project principal
+type User struct {
+	Email string `json:"email"`
+	Type  string `json:"type,omitempty"`
+}
+
+// ZoneAssignment links a zone to a project with its limits
+type ZoneAssignment struct {
+	ZoneID             string `json:"zoneId"`
+	Priority           int    `json:"priority,omitempty"`
+	MaxNumberInstances int    `json:"maxNumberInstances,omitempty"`
+	CPULimit           int    `json:"cpuLimit,omitempty"`
+	MemoryLimitMB      int64  `json:"memoryLimitMB,omitempty"`
+	StorageLimitGB     int64  `json:"storageLimitGB,omitempty"`
+}
+
+// Project is the IaaS view of a project
+type Project struct {
+	Resource
+	Administrators        []User            `json:"administrators,omitempty"`
+	Members               []User            `json:"members,omitempty"`
+	Viewers               []User            `json:"viewers,omitempty"`
+	Supervisors           []User            `json:"supervisors,omitempty"`
+	Zones                 []ZoneAssignment  `json:"zones,omitempty"`
+	OperationTimeout      int64             `json:"operationTimeout,omitempty"`
+	MachineNamingTemplate string            `json:"machineNamingTemplate,omitempty"`
+	SharedResources       bool              `json:"sharedResources,omitempty"`
+	PlacementPolicy       string            `json:"placementPolicy,omitempty"`
+	CustomProperties      map[string]string `json:"customProperties,omitempty"`
+}
+
+func (c *Client) ListProjects(ctx context.Context, opts *ListOptions) ([]Project, error) {
+	return list[Project](ctx, c, "/projects", opts)
+}
+
+func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
+	return get[Project](ctx, c, "/projects/"+url.PathEscape(id))
+}
+
+// FindProject gets a project by id or name
+func (c *Client) FindProject(ctx context.Context, ref string) (*Project, error) {
+	return find[Project](ctx, c, "/projects", ref, "project")
+}
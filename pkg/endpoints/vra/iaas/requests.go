@@ -0,0 +1,77 @@
+package iaas
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"path"
+	"time"
+
+	ierr "iv/pkg/error"
+)
+
+// request tracker states
+const (
+	RequestInProgress = "INPROGRESS"
+	RequestFinished   = "FINISHED"
+	RequestFailed     = "FAILED"
+)
+
+// pollInterval is how often Wait reads a request tracker
+const pollInterval = 3 * time.Second
+
+// RequestTracker follows an asynchronous IaaS operation
+type RequestTracker struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name,omitempty"`
+	Progress     int      `json:"progress"`
+	Status       string   `json:"status"`
+	Message      string   `json:"message,omitempty"`
+	Resources    []string `json:"resources,omitempty"`
+	SelfLink     string   `json:"selfLink,omitempty"`
+	DeploymentID string   `json:"deploymentId,omitempty"`
+}
+
+func (c *Client) GetRequestTracker(ctx context.Context, id string) (*RequestTracker, error) {
+	return get[RequestTracker](ctx, c, "/request-tracker/"+url.PathEscape(id))
+}
+
+// Wait polls a request tracker until it leaves INPROGRESS, a FAILED request
+// is returned together with an error carrying its message
+// a tracker without id (the operation answered 204) is already done
+func (c *Client) Wait(ctx context.Context, t *RequestTracker) (*RequestTracker, error) {
+	if t.ID == "" {
+		return t, nil
+	}
+	tick := time.NewTicker(pollInterval)
+	defer tick.Stop()
+	for t.Status == RequestInProgress || t.Status == "" {
+		select {
+		case <-ctx.Done():
+			return t, ctx.Err()
+		case <-tick.C:
+		}
+		next, err := c.GetRequestTracker(ctx, t.ID)
+		if err != nil {
+			return t, err
+		}
+		t = next
+	}
+	if t.Status == RequestFailed {
+		msg := t.Message
+		if msg == "" {
+			msg = "request failed"
+		}
+		return t, &ierr.Error{Operation: t.Name, Type: "request", Err: errors.New(msg)}
+	}
+	return t, nil
+}
+
+// ResourceIDs returns the ids at the end of the resource links of a request
+func (t *RequestTracker) ResourceIDs() []string {
+	var ids []string
+	for _, r := range t.Resources {
+		ids = append(ids, path.Base(r))
+	}
+	return ids
+}
@@ -0,0 +1,221 @@
+package iaas
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// the enumerations of rules and routes, in the case the API expects
+var (
+	ruleAccess      = []string{"Allow", "Deny", "Drop"}
+	ruleDirections  = []string{"Inbound", "Outbound"}
+	ruleProtocols   = []string{"ANY", "TCP", "UDP", "ICMP", "ICMPv6"}
+	routeProtocols  = []string{"TCP", "UDP", "HTTP", "HTTPS"}
+	healthProtocols = []string{"TCP", "UDP", "HTTP", "HTTPS", "ICMP"}
+)
+
+// canonical returns the value of allowed s matches case insensitively
+func canonical(s string, allowed []string) (string, bool) {
+	for _, a := range allowed {
+		if strings.EqualFold(s, a) {
+			return a, true
+		}
+	}
+	return s, false
+}
+
+func enum(field, s string, allowed []string) (string, error) {
+	v, ok := canonical(strings.TrimSpace(s), allowed)
+	if !ok {
+		return s, fmt.Errorf("%s %q is not one of %s", field, s, strings.Join(allowed, ", "))
+	}
+	return v, nil
+}
+
+// Validate checks a rule and puts its access, direction and protocol in the
+// case the API expects, a missing access is Allow like on the server
+func (r *Rule) Validate() error {
+	var errs []error
+	if r.Access == "" {
+		r.Access = ruleAccess[0]
+	}
+	var err error
+	if r.Access, err = enum("access", r.Access, ruleAccess); err != nil {
+		errs = append(errs, err)
+	}
+	if r.Direction, err = enum("direction", r.Direction, ruleDirections); err != nil {
+		errs = append(errs, err)
+	}
+	switch {
+	case r.Protocol == "" && r.Service == "":
+		errs = append(errs, errors.New("protocol or service is required"))
+	case r.Protocol != "":
+		if r.Protocol, err = enum("protocol", r.Protocol, ruleProtocols); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := validatePorts(r.Ports); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateCidrs(r.IPRangeCidr); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateRules validates every rule, errors name the rule they belong to
+func ValidateRules(rules []Rule) error {
+	var errs []error
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", label(i, rules[i].Name), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validatePorts accepts ANY or a comma separated list of ports and ranges
+func validatePorts(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("ports are required, use ANY for all")
+	}
+	if strings.EqualFold(strings.TrimSpace(s), "any") {
+		return nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		lo, hi, isRange := strings.Cut(part, "-")
+		from, err := parsePort(lo)
+		if err != nil {
+			return err
+		}
+		if !isRange {
+			continue
+		}
+		to, err := parsePort(hi)
+		if err != nil {
+			return err
+		}
+		if from > to {
+			return fmt.Errorf("port range %q is reversed", part)
+		}
+	}
+	return nil
+}
+
+func parsePort(s string) (int, error) {
+	p, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || p < 1 || p > 65535 {
+		return 0, fmt.Errorf("port %q is not a number from 1 to 65535", s)
+	}
+	return p, nil
+}
+
+// validateCidrs accepts ANY or a comma separated list of IPv4 or IPv6 CIDRs
+func validateCidrs(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return errors.New("ipRangeCidr is required, use ANY for all addresses")
+	}
+	if strings.EqualFold(strings.TrimSpace(s), "any") {
+		return nil
+	}
+	for _, part := range strings.Split(s, ",") {
+		if _, err := netip.ParsePrefix(strings.TrimSpace(part)); err != nil {
+			return fmt.Errorf("ipRangeCidr %q is not a CIDR, e.g. 10.0.0.0/8", strings.TrimSpace(part))
+		}
+	}
+	return nil
+}
+
+// Validate checks a route and its health check and puts the protocols in
+// upper case
+func (r *RouteConfiguration) Validate() error {
+	var errs []error
+	var err error
+	if r.Protocol, err = enum("protocol", r.Protocol, routeProtocols); err != nil {
+		errs = append(errs, err)
+	}
+	if r.MemberProtocol, err = enum("memberProtocol", r.MemberProtocol, routeProtocols); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := parsePort(r.Port); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := parsePort(r.MemberPort); err != nil {
+		errs = append(errs, fmt.Errorf("member %w", err))
+	}
+	if h := r.HealthCheckConfiguration; h != nil {
+		if h.Protocol != "" {
+			if h.Protocol, err = enum("health check protocol", h.Protocol, healthProtocols); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if h.Port != "" {
+			if _, err := parsePort(h.Port); err != nil {
+				errs = append(errs, fmt.Errorf("health check %w", err))
+			}
+		}
+		if h.IntervalSeconds < 0 || h.TimeoutSeconds < 0 || h.HealthyThreshold < 0 || h.UnhealthyThreshold < 0 {
+			errs = append(errs, errors.New("health check intervals and thresholds cannot be negative"))
+		}
+		if h.IntervalSeconds > 0 && h.TimeoutSeconds > h.IntervalSeconds {
+			errs = append(errs, errors.New("health check timeout is longer than its interval"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateRoutes validates every route, a load balancer needs at least one
+func ValidateRoutes(routes []RouteConfiguration) error {
+	if len(routes) == 0 {
+		return errors.New("at least one route is required")
+	}
+	var errs []error
+	for i := range routes {
+		if err := routes[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("route %s: %w", label(i, routes[i].Protocol+":"+routes[i].Port), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// label names the i-th element of a list in errors
+func label(i int, name string) string {
+	if strings.Trim(name, ": ") == "" {
+		return "#" + strconv.Itoa(i+1)
+	}
+	return fmt.Sprintf("#%d (%s)", i+1, name)
+}
+
+// ParseRule reads the short form direction:access:protocol:ports:cidr, e.g.
+// inbound:allow:tcp:443:10.0.0.0/8; the protocol may be a provider service
+// like SSH instead, and the cidr takes the rest so IPv6 works
+func ParseRule(s string) (Rule, error) {
+	parts := strings.SplitN(s, ":", 5)
+	if len(parts) != 5 {
+		return Rule{}, fmt.Errorf("rule %q is not direction:access:protocol:ports:cidr", s)
+	}
+	r := Rule{Direction: parts[0], Access: parts[1], Ports: parts[3], IPRangeCidr: parts[4]}
+	if _, ok := canonical(parts[2], ruleProtocols); ok {
+		r.Protocol = parts[2]
+	} else {
+		r.Service = parts[2]
+	}
+	return r, nil
+}
+
+// ParseRoute reads protocol:port[:memberProtocol:memberPort], e.g.
+// https:443:http:8080, the member side defaults to the listener side
+func ParseRoute(s string) (RouteConfiguration, error) {
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 2:
+		return RouteConfiguration{Protocol: parts[0], Port: parts[1], MemberProtocol: parts[0], MemberPort: parts[1]}, nil
+	case 4:
+		return RouteConfiguration{Protocol: parts[0], Port: parts[1], MemberProtocol: parts[2], MemberPort: parts[3]}, nil
+	}
+	return RouteConfiguration{}, fmt.Errorf("route %q is not protocol:port[:memberProtocol:memberPort]", s)
+}
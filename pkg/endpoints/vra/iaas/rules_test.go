@@ -0,0 +1,67 @@
+package iaas
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuleValidateNormalizes(t *testing.T) {
+	r := Rule{Protocol: "tcp", Direction: " inbound", Ports: "53, 1000-2000", IPRangeCidr: "10.0.0.0/8, fd00::/8"}
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Protocol != "TCP" || r.Direction != "Inbound" || r.Access != "Allow" {
+		t.Errorf("rule = %+v, want TCP, Inbound and the default Allow", r)
+	}
+
+	r = Rule{Service: "HTTPS", Access: "deny", Direction: "Outbound", Ports: "any", IPRangeCidr: "ANY"}
+	if err := r.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if r.Access != "Deny" || r.Protocol != "" {
+		t.Errorf("rule = %+v, want Deny and the service left as the protocol", r)
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	ok := Rule{Protocol: "TCP", Direction: "Inbound", Ports: "22", IPRangeCidr: "ANY"}
+	bad := func(edit func(*Rule)) Rule {
+		r := ok
+		edit(&r)
+		return r
+	}
+	rules := []Rule{
+		ok,
+		{Name: "empty"},
+		bad(func(r *Rule) { r.Access, r.Direction, r.Protocol = "permit", "in", "SCTP" }),
+		bad(func(r *Rule) { r.Ports = "0" }),
+		bad(func(r *Rule) { r.Ports = "2000-1000" }),
+		bad(func(r *Rule) { r.Ports = "70000" }),
+		bad(func(r *Rule) { r.IPRangeCidr = "10.0.0.1" }),
+	}
+	err := ValidateRules(rules)
+	if err == nil {
+		t.Fatal("ValidateRules accepted invalid rules")
+	}
+	got := err.Error()
+	for _, want := range []string{
+		"rule #2 (empty): direction",
+		"protocol or service is required",
+		"ports are required",
+		"ipRangeCidr is required",
+		`rule #3: access "permit" is not one of Allow, Deny, Drop`,
+		`direction "in"`,
+		`protocol "SCTP"`,
+		`rule #4: port "0" is not a number`,
+		`rule #5: port range "2000-1000" is reversed`,
+		`rule #6: port "70000"`,
+		`rule #7: ipRangeCidr "10.0.0.1" is not a CIDR`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("error does not mention %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "rule #1") {
+		t.Errorf("the valid rule is reported:\n%s", got)
+	}
+}
@@ -0,0 +1,107 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// SecurityGroup is a provisioned security group with its firewall rules
+type SecurityGroup struct {
+	Resource
+	ExternalID         string            `json:"externalId,omitempty"`
+	ProjectID          string            `json:"projectId,omitempty"`
+	ExternalZoneID     string            `json:"externalZoneId,omitempty"`
+	ExternalRegionID   string            `json:"externalRegionId,omitempty"`
+	CloudAccountIDs    []string          `json:"cloudAccountIds,omitempty"`
+	DeploymentID       string            `json:"deploymentId,omitempty"`
+	ProvisioningStatus string            `json:"provisioningStatus,omitempty"`
+	Tags               []Tag             `json:"tags,omitempty"`
+	CustomProperties   map[string]string `json:"customProperties,omitempty"`
+	Rules              []Rule            `json:"rules,omitempty"`
+}
+
+// Rule is a firewall rule of a security group, either Protocol or Service
+// is set
+type Rule struct {
+	Name        string `json:"name,omitempty"`
+	Protocol    string `json:"protocol,omitempty"`
+	Service     string `json:"service,omitempty"`
+	IPRangeCidr string `json:"ipRangeCidr"`
+	Ports       string `json:"ports"`
+	Access      string `json:"access"`
+	Direction   string `json:"direction"`
+}
+
+// SecurityGroupSpecification describes a security group to create, or the
+// rules to reconfigure one with
+type SecurityGroupSpecification struct {
+	Name             string            `json:"name"`
+	ProjectID        string            `json:"projectId"`
+	Description      string            `json:"description,omitempty"`
+	DeploymentID     string            `json:"deploymentId,omitempty"`
+	Rules            []Rule            `json:"rules,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+	Tags             []Tag             `json:"tags,omitempty"`
+}
+
+// Validate checks the rules of a specification before it is sent and puts
+// their enumerations in the case the API expects
+func (s *SecurityGroupSpecification) Validate() error {
+	return ValidateRules(s.Rules)
+}
+
+// UpdateSecurityGroupSpecification changes the tags of a security group
+type UpdateSecurityGroupSpecification struct {
+	Tags []Tag `json:"tags"`
+}
+
+// ChangeSecurityGroupSpecification sets the security groups of the network
+// interfaces of a vSphere machine
+type ChangeSecurityGroupSpecification struct {
+	ID                             string                          `json:"id"`
+	Links                          map[string]Link                 `json:"_links"`
+	NetworkInterfaceSpecifications []NetworkInterfaceSpecification `json:"networkInterfaceSpecifications"`
+}
+
+func securityGroupPath(id string) string {
+	return "/security-groups/" + url.PathEscape(id)
+}
+
+func (c *Client) ListSecurityGroups(ctx context.Context, opts *ListOptions) ([]SecurityGroup, error) {
+	return list[SecurityGroup](ctx, c, "/security-groups", opts)
+}
+
+func (c *Client) GetSecurityGroup(ctx context.Context, id string) (*SecurityGroup, error) {
+	return get[SecurityGroup](ctx, c, securityGroupPath(id))
+}
+
+// FindSecurityGroup accepts a security group id or name
+func (c *Client) FindSecurityGroup(ctx context.Context, ref string) (*SecurityGroup, error) {
+	return find[SecurityGroup](ctx, c, "/security-groups", ref, "security group")
+}
+
+func (c *Client) CreateSecurityGroup(ctx context.Context, spec SecurityGroupSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, "/security-groups", nil, spec)
+}
+
+// UpdateSecurityGroup replaces the tags of a security group
+func (c *Client) UpdateSecurityGroup(ctx context.Context, id string, spec UpdateSecurityGroupSpecification) (*SecurityGroup, error) {
+	var sg SecurityGroup
+	if _, err := c.rest.Do(ctx, http.MethodPatch, apiPrefix+securityGroupPath(id), nil, spec, &sg); err != nil {
+		return nil, err
+	}
+	return &sg, nil
+}
+
+// ReconfigureSecurityGroup replaces the rules of a security group
+func (c *Client) ReconfigureSecurityGroup(ctx context.Context, id string, spec SecurityGroupSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, securityGroupPath(id)+"/operations/reconfigure", nil, spec)
+}
+
+// ChangeSecurityGroups sets the security groups of machine network
+// interfaces, only groups of the machine's deployment can be used
+func (c *Client) ChangeSecurityGroups(ctx context.Context, m *Machine, nics []NetworkInterfaceSpecification) (*RequestTracker, error) {
+	spec := ChangeSecurityGroupSpecification{ID: m.ID, Links: map[string]Link{}, NetworkInterfaceSpecifications: nics}
+	return c.track(ctx, http.MethodPost, machinePath(m.ID)+"/operations/change-security-groups", nil, spec)
+}
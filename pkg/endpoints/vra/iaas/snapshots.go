@@ -0,0 +1,41 @@
+package iaas
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// Snapshot is a machine snapshot
+type Snapshot struct {
+	Resource
+	IsCurrent bool `json:"isCurrent,omitempty"`
+}
+
+// SnapshotSpecification describes a snapshot to take
+type SnapshotSpecification struct {
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	SnapshotMemory   bool              `json:"snapshotMemory,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+}
+
+func machinePath(id string) string {
+	return "/machines/" + url.PathEscape(id)
+}
+
+func (c *Client) ListMachineSnapshots(ctx context.Context, machineID string) ([]Snapshot, error) {
+	return items[Snapshot](ctx, c, machinePath(machineID)+"/snapshots")
+}
+
+func (c *Client) CreateMachineSnapshot(ctx context.Context, machineID string, spec SnapshotSpecification) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, machinePath(machineID)+"/operations/snapshots", nil, spec)
+}
+
+func (c *Client) DeleteMachineSnapshot(ctx context.Context, machineID, snapshotID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodDelete, machinePath(machineID)+"/snapshots/"+url.PathEscape(snapshotID), nil, nil)
+}
+
+func (c *Client) RevertMachineSnapshot(ctx context.Context, machineID, snapshotID string) (*RequestTracker, error) {
+	return c.track(ctx, http.MethodPost, machinePath(machineID)+"/operations/revert/"+url.PathEscape(snapshotID), nil, nil)
+}
@@ -0,0 +1,198 @@
+package iaas
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// TagCollections are the collections tags can be set on in bulk, by the
+// kind name used on the command line
+var TagCollections = map[string]string{
+	"machines": "/machines",
+	"networks": "/fabric-networks",
+}
+
+func (c *Client) ListTags(ctx context.Context, opts *ListOptions) ([]Tag, error) {
+	return list[Tag](ctx, c, "/tags", opts)
+}
+
+// FindTags lists the tags with a key, and with a value when value is set
+func (c *Client) FindTags(ctx context.Context, key, value string) ([]Tag, error) {
+	filter := "key eq " + Quote(key)
+	if value != "" {
+		filter += " and value eq " + Quote(value)
+	}
+	return c.ListTags(ctx, &ListOptions{Filter: filter})
+}
+
+func (c *Client) CreateTag(ctx context.Context, key, value string) (*Tag, error) {
+	var t Tag
+	if err := c.rest.Post(ctx, apiPrefix+"/tags", nil, Tag{Key: key, Value: value}, &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// DeleteTag deletes a tag, a tag in use is refused unless ignoreUsage
+// removes it from its resources first
+func (c *Client) DeleteTag(ctx context.Context, id string, ignoreUsage bool) error {
+	var q url.Values
+	if ignoreUsage {
+		q = url.Values{"ignoreUsage": {strconv.FormatBool(true)}}
+	}
+	return c.rest.Delete(ctx, apiPrefix+"/tags/"+url.PathEscape(id), q, nil)
+}
+
+// TaggedCollections are the collections whose entities carry tags, read to
+// find where tags are used; tags-usage answers 202 without a body so it
+// cannot tell
+var TaggedCollections = []string{
+	"/machines", "/block-devices", "/networks", "/load-balancers", "/security-groups",
+	"/fabric-computes", "/fabric-networks", "/fabric-vsphere-datastores", "/fabric-vsphere-storage-policies",
+	"/zones", "/network-profiles", "/storage-profiles", "/cloud-accounts",
+	"/compute-gateways", "/compute-nats", "/network-domains", "/network-ip-ranges", "/external-network-ip-ranges",
+}
+
+// TaggedResource is an entity carrying a tag and the collection it is in
+type TaggedResource struct {
+	Collection string `json:"collection"`
+	ID         string `json:"id"`
+	Name       string `json:"name,omitempty"`
+}
+
+// TagIndex maps every key and value in use to the resources carrying it,
+// tag ids are not part of the key
+type TagIndex map[Tag][]TaggedResource
+
+// IndexTags reads every entity of TaggedCollections, a collection that cannot
+// be read in full fails the index rather than leave tags looking unused
+func (c *Client) IndexTags(ctx context.Context) (TagIndex, error) {
+	idx := TagIndex{}
+	for _, coll := range TaggedCollections {
+		objs, err := c.ListAllObjects(ctx, coll)
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", coll, err)
+		}
+		for _, o := range objs {
+			r := TaggedResource{Collection: coll}
+			r.ID, _ = o["id"].(string)
+			r.Name, _ = o["name"].(string)
+			tags, _ := o["tags"].([]any)
+			for _, t := range tags {
+				m, _ := t.(map[string]any)
+				key, _ := m["key"].(string)
+				value, _ := m["value"].(string)
+				if key != "" {
+					k := Tag{Key: key, Value: value}
+					idx[k] = append(idx[k], r)
+				}
+			}
+		}
+	}
+	return idx, nil
+}
+
+// Used reports whether any resource carries a tag
+func (x TagIndex) Used(t Tag) bool {
+	return len(x[Tag{Key: t.Key, Value: t.Value}]) > 0
+}
+
+// Using returns the resources that carry all of the given tags
+func (x TagIndex) Using(tags []Tag) []TaggedResource {
+	if len(tags) == 0 {
+		return nil
+	}
+	count := map[TaggedResource]int{}
+	var out []TaggedResource
+	for i, t := range tags {
+		for _, r := range x[Tag{Key: t.Key, Value: t.Value}] {
+			// a resource is counted once per tag, even with the tag twice
+			if count[r] != i {
+				continue
+			}
+			if count[r]++; count[r] == len(tags) {
+				out = append(out, r)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Collection != out[j].Collection {
+			return out[i].Collection < out[j].Collection
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+// ParseTag reads key=value or key:value, a bare key is a tag without value
+func ParseTag(s string) (Tag, error) {
+	key, value, _ := strings.Cut(s, "=")
+	if !strings.Contains(s, "=") {
+		key, value, _ = strings.Cut(s, ":")
+	}
+	if key = strings.TrimSpace(key); key == "" {
+		return Tag{}, fmt.Errorf("tag %q has no key", s)
+	}
+	return Tag{Key: key, Value: strings.TrimSpace(value)}, nil
+}
+
+// ParseTagChanges reads key=value arguments as tags to set and key- as keys
+// to remove
+func ParseTagChanges(args []string) (set []Tag, remove []string, err error) {
+	for _, arg := range args {
+		if key, ok := strings.CutSuffix(arg, "-"); ok && !strings.ContainsAny(arg, "=:") {
+			remove = append(remove, key)
+			continue
+		}
+		t, err := ParseTag(arg)
+		if err != nil {
+			return nil, nil, err
+		}
+		set = append(set, t)
+	}
+	return set, remove, nil
+}
+
+// MergeTags replaces every tag of current whose key is in set or remove by
+// the tags of set, other tags are kept as they are (keys may repeat); the
+// result is sorted and reports whether it differs from current
+func MergeTags(current, set []Tag, remove []string) ([]Tag, bool) {
+	drop := map[string]bool{}
+	for _, t := range set {
+		drop[t.Key] = true
+	}
+	for _, k := range remove {
+		drop[k] = true
+	}
+	var out []Tag
+	for _, t := range current {
+		if !drop[t.Key] {
+			out = append(out, Tag{Key: t.Key, Value: t.Value})
+		}
+	}
+	out = append(out, set...)
+	sortTags(out)
+	before := make([]Tag, 0, len(current))
+	for _, t := range current {
+		before = append(before, Tag{Key: t.Key, Value: t.Value})
+	}
+	sortTags(before)
+	changed := len(before) != len(out)
+	for i := 0; !changed && i < len(out); i++ {
+		changed = before[i] != out[i]
+	}
+	return out, changed
+}
+
+func sortTags(tags []Tag) {
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Key != tags[j].Key {
+			return tags[i].Key < tags[j].Key
+		}
+		return tags[i].Value < tags[j].Value
+	})
+}
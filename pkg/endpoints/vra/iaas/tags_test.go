@@ -0,0 +1,102 @@
+package iaas
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// tagList renders tags as key=value, sorted as MergeTags leaves them
+func tagList(tags []Tag) string {
+	var s []string
+	for _, t := range tags {
+		s = append(s, t.Key+"="+t.Value)
+	}
+	return strings.Join(s, " ")
+}
+
+func TestMergeTags(t *testing.T) {
+	current := []Tag{{ID: "1", Key: "team", Value: "a"}, {ID: "2", Key: "env", Value: "dev"},
+		{ID: "3", Key: "owner", Value: "x"}, {ID: "4", Key: "owner", Value: "y"}}
+	for _, tt := range []struct {
+		args    string
+		want    string
+		changed bool
+	}{
+		{"", "env=dev owner=x owner=y team=a", false},
+		{"env=dev", "env=dev owner=x owner=y team=a", false},
+		{"env=prod", "env=prod owner=x owner=y team=a", true},
+		// set replaces every tag of a repeated key
+		{"owner:z", "env=dev owner=z team=a", true},
+		{"owner- team-", "env=dev", true},
+		{"missing-", "env=dev owner=x owner=y team=a", false},
+		{"team=a team=b", "env=dev owner=x owner=y team=a team=b", true},
+		{"new", "env=dev new= owner=x owner=y team=a", true},
+	} {
+		set, remove, err := ParseTagChanges(strings.Fields(tt.args))
+		if err != nil {
+			t.Fatalf("%q: %v", tt.args, err)
+		}
+		got, changed := MergeTags(current, set, remove)
+		if tagList(got) != tt.want || changed != tt.changed {
+			t.Errorf("%q: MergeTags() = %s, %v, want %s, %v", tt.args, tagList(got), changed, tt.want, tt.changed)
+		}
+		for _, g := range got {
+			if g.ID != "" {
+				t.Errorf("%q: merged tag %s kept id %s", tt.args, g.Key, g.ID)
+			}
+		}
+	}
+}
+
+func TestParseTagChanges(t *testing.T) {
+	set, remove, err := ParseTagChanges([]string{"env=prod", "os:linux", "tmp-", "a-b=c", " spaced = v "})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := fmt.Sprint(set), "[{ env prod} { os linux} { a-b c} { spaced v}]"; got != want {
+		t.Errorf("set = %s, want %s", got, want)
+	}
+	if got, want := fmt.Sprint(remove), "[tmp]"; got != want {
+		t.Errorf("remove = %s, want %s", got, want)
+	}
+	if _, _, err := ParseTagChanges([]string{"=v"}); err == nil {
+		t.Error("a tag without key was accepted")
+	}
+}
+
+func TestTagIndexUsing(t *testing.T) {
+	web := TaggedResource{Collection: "/machines", ID: "m1", Name: "web"}
+	db := TaggedResource{Collection: "/machines", ID: "m2", Name: "db"}
+	zone := TaggedResource{Collection: "/zones", ID: "z1", Name: "prod"}
+	idx := TagIndex{
+		{Key: "env", Value: "prod"}: {web, db, zone},
+		{Key: "role", Value: "web"}: {web, web},
+		{Key: "tier"}:               {zone, db},
+	}
+	names := func(rs []TaggedResource) string {
+		var s []string
+		for _, r := range rs {
+			s = append(s, r.Name)
+		}
+		return strings.Join(s, " ")
+	}
+	for _, tt := range []struct {
+		tags []Tag
+		want string
+	}{
+		{[]Tag{{Key: "env", Value: "prod"}}, "db web prod"},
+		{[]Tag{{ID: "t1", Key: "env", Value: "prod"}, {Key: "tier"}}, "db prod"},
+		// the same tag twice on a resource does not make up for a missing one
+		{[]Tag{{Key: "role", Value: "web"}, {Key: "tier"}}, ""},
+		{[]Tag{{Key: "env", Value: "dev"}}, ""},
+		{nil, ""},
+	} {
+		if got := names(idx.Using(tt.tags)); got != tt.want {
+			t.Errorf("Using(%v) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+	if !idx.Used(Tag{ID: "x", Key: "tier"}) || idx.Used(Tag{Key: "tier", Value: "1"}) {
+		t.Error("Used() does not match on key and value only")
+	}
+}
@@ -0,0 +1,205 @@
+package iaas
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"iv/pkg/rest"
+)
+
+// tus resumable uploads (https://tus.io/protocols/resumable-upload), used by
+// the IPAM package import: POST reserves an upload and answers with its
+// Location, PATCH sends chunks at Upload-Offset and HEAD tells how much the
+// server already has, so an interrupted upload continues where it stopped
+
+const (
+	// TusVersion is sent in Tus-Resumable on every request
+	TusVersion = "1.0.0"
+	// DefaultChunkSize is the PATCH body size when Upload.ChunkSize is unset
+	DefaultChunkSize = 4 << 20
+	// defaultRetries is how often a failing chunk is retried
+	defaultRetries = 5
+)
+
+// retryDelay is the pause before the first retry of a chunk, it grows with
+// every further failure
+var retryDelay = time.Second
+
+// Upload is one tus upload
+type Upload struct {
+	// URL is the upload location, set it to resume an earlier upload
+	URL string
+	// Size is the length of the whole file
+	Size int64
+	// ChunkSize is the size of every PATCH, DefaultChunkSize when 0
+	ChunkSize int64
+	// Retries is how often a failed chunk is retried after rereading the
+	// offset, 5 when 0
+	Retries int
+	// Progress is called with the offset confirmed by the server
+	Progress func(offset, size int64)
+}
+
+// ID is the last element of the upload location
+func (u *Upload) ID() string {
+	return path.Base(u.URL)
+}
+
+func (c *Client) tusRequest(ctx context.Context, method, target string, body any) (*http.Request, error) {
+	// locations may be absolute, requests are built relative to the server
+	if loc, err := url.Parse(target); err == nil && loc.IsAbs() {
+		target = loc.RequestURI()
+	}
+	req, err := c.rest.NewRequest(ctx, method, target, nil, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Tus-Resumable", TusVersion)
+	return req, nil
+}
+
+// CreateUpload reserves an upload of size bytes on a tus collection and
+// returns its location
+func (c *Client) CreateUpload(ctx context.Context, collection string, size int64) (string, error) {
+	req, err := c.tusRequest(ctx, http.MethodPost, apiPrefix+collection, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Upload-Length", strconv.FormatInt(size, 10))
+	rsp, err := c.rest.Send(req, nil)
+	if err != nil {
+		return "", err
+	}
+	loc := rsp.Header.Get("Location")
+	if loc == "" {
+		return "", errors.New("tus: upload created without a Location")
+	}
+	return loc, nil
+}
+
+// UploadOffset asks the server how many bytes of an upload it has
+func (c *Client) UploadOffset(ctx context.Context, location string) (int64, error) {
+	req, err := c.tusRequest(ctx, http.MethodHead, location, nil)
+	if err != nil {
+		return 0, err
+	}
+	rsp, err := c.rest.Send(req, nil)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(rsp.Header.Get("Upload-Offset"), 10, 64)
+}
+
+// sendChunk PATCHes one chunk and returns the new offset
+func (c *Client) sendChunk(ctx context.Context, location string, offset int64, chunk []byte) (int64, error) {
+	req, err := c.tusRequest(ctx, http.MethodPatch, location, bytes.NewReader(chunk))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/offset+octet-stream")
+	req.Header.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	rsp, err := c.rest.Send(req, nil)
+	if err != nil {
+		return 0, err
+	}
+	if next, err := strconv.ParseInt(rsp.Header.Get("Upload-Offset"), 10, 64); err == nil {
+		return next, nil
+	}
+	return offset + int64(len(chunk)), nil
+}
+
+// Upload sends r to the server in chunks, starting with a new upload when
+// u.URL is empty or gone and from the offset the server reports otherwise
+// u.URL is set as soon as the upload exists so callers can keep it for a
+// later resume
+func (c *Client) Upload(ctx context.Context, collection string, u *Upload, r io.ReaderAt) error {
+	chunkSize, retries := u.ChunkSize, u.Retries
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if retries <= 0 {
+		retries = defaultRetries
+	}
+
+	var offset int64
+	if u.URL != "" {
+		var err error
+		offset, err = c.UploadOffset(ctx, u.URL)
+		switch code := rest.StatusCode(err); {
+		case err == nil:
+		case code == http.StatusNotFound || code == http.StatusGone || code == http.StatusPreconditionFailed:
+			// expired or unknown, start over
+			u.URL, offset = "", 0
+		default:
+			return err
+		}
+	}
+	if u.URL == "" {
+		loc, err := c.CreateUpload(ctx, collection, u.Size)
+		if err != nil {
+			return err
+		}
+		u.URL = loc
+	}
+	if u.Progress != nil {
+		u.Progress(offset, u.Size)
+	}
+
+	buf := make([]byte, chunkSize)
+	failures := 0
+	for offset < u.Size {
+		n, err := r.ReadAt(buf[:min(chunkSize, u.Size-offset)], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("tus: file ended at %d of %d bytes, it changed during the upload", offset, u.Size)
+		}
+		next, err := c.sendChunk(ctx, u.URL, offset, buf[:n])
+		if err != nil {
+			failures++
+			if failures > retries || ctx.Err() != nil || !retryable(err) {
+				return fmt.Errorf("tus: upload stopped at %d of %d bytes: %w", offset, u.Size, err)
+			}
+			if err := sleep(ctx, time.Duration(failures)*retryDelay); err != nil {
+				return err
+			}
+			// the chunk may have arrived partly, ask where to continue
+			if o, herr := c.UploadOffset(ctx, u.URL); herr == nil {
+				offset = o
+			}
+			continue
+		}
+		failures = 0
+		offset = next
+		if u.Progress != nil {
+			u.Progress(offset, u.Size)
+		}
+	}
+	return nil
+}
+
+// retryable is true for transport failures, offset conflicts and server errors
+func retryable(err error) bool {
+	code := rest.StatusCode(err)
+	return code == 0 || code == http.StatusConflict || code >= 500
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,161 @@
+package iaas
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"iv/pkg/rest"
+)
+
+// tusServer keeps uploads in memory, fail answers the next PATCHes with the
+// given status codes
+type tusServer struct {
+	mu      sync.Mutex
+	uploads map[string]*bytes.Buffer
+	created int
+	fail    []int
+	patches int
+}
+
+func (s *tusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r.Header.Get("Tus-Resumable") != TusVersion {
+		http.Error(w, "no Tus-Resumable", http.StatusPreconditionFailed)
+		return
+	}
+	if r.Method == http.MethodPost {
+		s.created++
+		loc := "/iaas/api/integrations-ipam/package-import/u" + strconv.Itoa(s.created)
+		s.uploads[loc] = &bytes.Buffer{}
+		w.Header().Set("Location", "https://vra.example"+loc)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	buf, ok := s.uploads[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("Upload-Offset", strconv.Itoa(buf.Len()))
+	case http.MethodPatch:
+		s.patches++
+		if len(s.fail) > 0 {
+			code := s.fail[0]
+			s.fail = s.fail[1:]
+			http.Error(w, "{}", code)
+			return
+		}
+		if r.Header.Get("Upload-Offset") != strconv.Itoa(buf.Len()) {
+			http.Error(w, "{}", http.StatusConflict)
+			return
+		}
+		io.Copy(buf, r.Body)
+		w.Header().Set("Upload-Offset", strconv.Itoa(buf.Len()))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func newTusClient(t *testing.T) (*Client, *tusServer) {
+	t.Helper()
+	retryDelay = 0
+	s := &tusServer{uploads: map[string]*bytes.Buffer{}}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	c, err := rest.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(c), s
+}
+
+const collection = "/integrations-ipam/package-import"
+
+func TestUpload(t *testing.T) {
+	c, s := newTusClient(t)
+	data := strings.Repeat("0123456789", 10)
+	var progress []int64
+	u := &Upload{Size: int64(len(data)), ChunkSize: 32, Progress: func(o, _ int64) { progress = append(progress, o) }}
+	if err := c.Upload(context.Background(), collection, u, strings.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if u.ID() != "u1" || s.uploads["/iaas/api"+collection+"/u1"].String() != data {
+		t.Errorf("upload %s does not hold the file", u.URL)
+	}
+	if got := fmt.Sprint(progress); got != "[0 32 64 96 100]" {
+		t.Errorf("progress = %s", got)
+	}
+}
+
+func TestUploadResume(t *testing.T) {
+	c, s := newTusClient(t)
+	data := strings.Repeat("x", 50)
+	loc := "/iaas/api" + collection + "/earlier"
+	s.uploads[loc] = bytes.NewBufferString(data[:20])
+
+	u := &Upload{URL: "https://vra.example" + loc, Size: 50, ChunkSize: 16}
+	if err := c.Upload(context.Background(), collection, u, strings.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if s.created != 0 || s.uploads[loc].String() != data {
+		t.Errorf("resume created %d uploads and left %q", s.created, s.uploads[loc])
+	}
+	if s.patches != 2 {
+		t.Errorf("resume sent %d chunks, want the remaining 30 bytes in 2", s.patches)
+	}
+}
+
+func TestUploadRestartsWhenGone(t *testing.T) {
+	c, s := newTusClient(t)
+	u := &Upload{URL: "/iaas/api" + collection + "/expired", Size: 5}
+	if err := c.Upload(context.Background(), collection, u, strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if s.created != 1 || u.ID() != "u1" || s.uploads["/iaas/api"+collection+"/u1"].String() != "hello" {
+		t.Errorf("upload went to %s after %d creates", u.URL, s.created)
+	}
+}
+
+func TestUploadRetries(t *testing.T) {
+	c, s := newTusClient(t)
+	s.fail = []int{http.StatusServiceUnavailable, http.StatusConflict}
+	u := &Upload{Size: 5, Retries: 2}
+	if err := c.Upload(context.Background(), collection, u, strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if s.patches != 3 || s.uploads["/iaas/api"+collection+"/u1"].String() != "hello" {
+		t.Errorf("%d patches, upload holds %q", s.patches, s.uploads["/iaas/api"+collection+"/u1"])
+	}
+
+	// a client error is not retried, and retries run out
+	for _, fail := range [][]int{{http.StatusForbidden}, {500, 500, 500}} {
+		c, s = newTusClient(t)
+		s.fail = fail
+		err := c.Upload(context.Background(), collection, &Upload{Size: 5, Retries: 2}, strings.NewReader("hello"))
+		if err == nil || !strings.Contains(err.Error(), "upload stopped at 0 of 5 bytes") || s.patches != len(fail) {
+			t.Errorf("fail %v: %d patches, err %v", fail, s.patches, err)
+		}
+	}
+}
+
+func TestUploadFileShrank(t *testing.T) {
+	c, s := newTusClient(t)
+	u := &Upload{Size: 10, ChunkSize: 4}
+	err := c.Upload(context.Background(), collection, u, strings.NewReader("hello"))
+	if err == nil || !strings.Contains(err.Error(), "file ended at 5 of 10 bytes") {
+		t.Errorf("Upload() = %v", err)
+	}
+	if s.patches != 2 {
+		t.Errorf("%d patches, want the 5 bytes there are in 2", s.patches)
+	}
+}
@@ -0,0 +1,28 @@
+package iaas
+
+// Link is a HATEOAS link of a resource
+type Link struct {
+	Href  string   `json:"href,omitempty"`
+	Hrefs []string `json:"hrefs,omitempty"`
+}
+
+// Resource holds the fields every IaaS entity carries
+type Resource struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	CreatedAt   string          `json:"createdAt,omitempty"`
+	UpdatedAt   string          `json:"updatedAt,omitempty"`
+	Owner       string          `json:"owner,omitempty"`
+	OwnerType   string          `json:"ownerType,omitempty"`
+	OrgID       string          `json:"orgId,omitempty"`
+	Links       map[string]Link `json:"_links,omitempty"`
+}
+
+// Tag is a key with an optional value, ID is only set on the entities of
+// the /tags collection
+type Tag struct {
+	ID    string `json:"id,omitempty"`
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
@@ -0,0 +1,30 @@
+package iaas
+
+import (
+	"context"
+	"net/url"
+)
+
+// Zone is a cloud zone, a placement target inside a region
+type Zone struct {
+	Resource
+	Tags             []Tag             `json:"tags,omitempty"`
+	TagsToMatch      []Tag             `json:"tagsToMatch,omitempty"`
+	PlacementPolicy  string            `json:"placementPolicy,omitempty"`
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+	Folder           string            `json:"folder,omitempty"`
+	ExternalRegionID string            `json:"externalRegionId,omitempty"`
+	CloudAccountID   string            `json:"cloudAccountId,omitempty"`
+}
+
+func (c *Client) ListZones(ctx context.Context, opts *ListOptions) ([]Zone, error) {
+	return list[Zone](ctx, c, "/zones", opts)
+}
+
+func (c *Client) GetZone(ctx context.Context, id string) (*Zone, error) {
+	return get[Zone](ctx, c, "/zones/"+url.PathEscape(id))
+}
+
+func (c *Client) ListRegions(ctx context.Context, opts *ListOptions) ([]Region, error) {
+	return list[Region](ctx, c, "/regions", opts)
+}
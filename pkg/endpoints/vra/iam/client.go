@@ -0,0 +1,132 @@
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+	ierr "iv/pkg/error"
+	"iv/pkg/rest"
+)
+
+// package iam puts paging and decoding on top of the generated auth client
+// for the org, user, group and role endpoints (/csp/gateway/am/api)
+// the spec declares most responses as */* so the generated client only hands
+// back raw bodies, they are decoded into the generated models here
+
+// pageLimit is the largest page the org endpoints accept
+const pageLimit = 200
+
+// Client wraps the generated auth client
+type Client struct {
+	api vra8.ClientWithResponsesInterface
+}
+
+// New builds the generated client on the same doer and editors as c
+func New(c *rest.Client) (*Client, error) {
+	api, err := vra8.NewClientWithResponses(c.Server,
+		vra8.WithHTTPClient(c.Client),
+		vra8.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			for _, e := range c.RequestEditors {
+				if err := e(ctx, req); err != nil {
+					return err
+				}
+			}
+			return nil
+		}))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: api}, nil
+}
+
+// transport wraps an error from the generated client before any response
+func transport(op string, err error) error {
+	return &ierr.Error{Operation: op, Type: "transport", Err: err}
+}
+
+// decode checks the status and unmarshals body into out (when non nil)
+func decode(op string, code int, body []byte, out any) error {
+	if code < 200 || code > 299 {
+		return &ierr.Error{Operation: op, Type: "status", Err: &rest.StatusError{
+			StatusCode: code,
+			Status:     fmt.Sprintf("%d %s", code, http.StatusText(code)),
+			Body:       body,
+		}}
+	}
+	if out == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return &ierr.Error{Operation: op, Type: "decode", Err: err}
+	}
+	return nil
+}
+
+// paged is the envelope of every PagedResponse* model
+type paged[T any] struct {
+	NextLink *string `json:"nextLink,omitempty"`
+	Results  *[]T    `json:"results,omitempty"`
+}
+
+// pageFn fetches the page starting at the 1-based index start
+type pageFn func(start, limit *int) (code int, body []byte, err error)
+
+// collect walks pageStart/pageLimit pages until the results run out
+func collect[T any](op string, fetch pageFn) ([]T, error) {
+	var all []T
+	start, limit := 1, pageLimit
+	for {
+		code, body, err := fetch(&start, &limit)
+		if err != nil {
+			return nil, transport(op, err)
+		}
+		var page paged[T]
+		if err := decode(op, code, body, &page); err != nil {
+			return nil, err
+		}
+		if page.Results == nil || len(*page.Results) == 0 {
+			return all, nil
+		}
+		all = append(all, *page.Results...)
+		start += len(*page.Results)
+		if Str(page.NextLink) == "" || len(*page.Results) < limit {
+			return all, nil
+		}
+	}
+}
+
+// DefaultOrg returns the id of the logged in user's default org
+func (c *Client) DefaultOrg(ctx context.Context) (string, error) {
+	const op = "get default org"
+	rsp, err := c.api.GetUserDefaultOrgWithResponse(ctx)
+	if err != nil {
+		return "", transport(op, err)
+	}
+	var link vra8.RefLink
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &link); err != nil {
+		return "", err
+	}
+	if link.RefLink == nil || *link.RefLink == "" {
+		return "", errors.New("logged in user has no default org")
+	}
+	return path.Base(strings.TrimSuffix(*link.RefLink, "/")), nil
+}
+
+// Str dereferences an optional string from the generated models
+func Str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ServiceID extracts the service definition id from a service definition link
+func ServiceID(link string) string {
+	return link[strings.LastIndex(link, "/")+1:]
+}
@@ -0,0 +1,79 @@
+package iam
+
+import (
+	"context"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+)
+
+// ListOrgGroups walks every page of the groups added to the org
+func (c *Client) ListOrgGroups(ctx context.Context, orgID string) ([]vra8.ExpandedGroup, error) {
+	return collect[vra8.ExpandedGroup]("list org groups", func(start, limit *int) (int, []byte, error) {
+		rsp, err := c.api.GetOrganizationGroupsWithResponse(ctx, orgID, &vra8.GetOrganizationGroupsParams{
+			PageStart: start,
+			PageLimit: limit,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return rsp.StatusCode(), rsp.Body, nil
+	})
+}
+
+// SearchOrgGroups finds groups in the org's identity provider by name
+func (c *Client) SearchOrgGroups(ctx context.Context, orgID, term string) ([]vra8.ExpandedGroup, error) {
+	const op = "search org groups"
+	rsp, err := c.api.SearchOrgGroupsWithResponse(ctx, orgID, &vra8.SearchOrgGroupsParams{GroupSearchTerm: term})
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.SearchGroupsResult
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	return res.Results, nil
+}
+
+// RemoveOrgGroups removes groups (and the access they grant) from the org
+func (c *Client) RemoveOrgGroups(ctx context.Context, orgID string, groupIDs []string) (*vra8.RemoveMembersFromOrgResponse, error) {
+	const op = "remove org groups"
+	rsp, err := c.api.RemoveGroupsFromOrganizationWithResponse(ctx, orgID, vra8.RemoveGroupsFromOrgRequest{Ids: &groupIDs})
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.RemoveMembersFromOrgResponse
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ListNestedGroups returns the groups nested inside a directory group
+func (c *Client) ListNestedGroups(ctx context.Context, orgID, groupID string) ([]vra8.Group, error) {
+	return collect[vra8.Group]("list nested groups", func(start, limit *int) (int, []byte, error) {
+		rsp, err := c.api.GetNestedGroupsFromADGroupWithResponse(ctx, orgID, groupID, &vra8.GetNestedGroupsFromADGroupParams{
+			PageStart: start,
+			PageLimit: limit,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return rsp.StatusCode(), rsp.Body, nil
+	})
+}
+
+// ListGroupUsers returns the users of a group, direct only skips users that
+// are members through nested groups
+func (c *Client) ListGroupUsers(ctx context.Context, orgID, groupID string, directOnly bool) ([]vra8.BaseUser, error) {
+	return collect[vra8.BaseUser]("list group users", func(start, limit *int) (int, []byte, error) {
+		rsp, err := c.api.GetPaginatedGroupUsersWithResponse(ctx, orgID, groupID, &vra8.GetPaginatedGroupUsersParams{
+			PageStart:       start,
+			PageLimit:       limit,
+			OnlyDirectUsers: &directOnly,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return rsp.StatusCode(), rsp.Body, nil
+	})
+}
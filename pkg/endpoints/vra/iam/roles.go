@@ -0,0 +1,91 @@
+package iam
+
+import (
+	"context"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+)
+
+// ListOrgRoles returns the organization roles defined in the org
+func (c *Client) ListOrgRoles(ctx context.Context, orgID string) ([]vra8.RoleResponse, error) {
+	const op = "list org roles"
+	expand := true
+	rsp, err := c.api.GetOrgRolesWithResponse(ctx, orgID, &vra8.GetOrgRolesParams{Expand: &expand})
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.OrganizationRolesResponse
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	if res.OrgRolesData == nil {
+		return nil, nil
+	}
+	return *res.OrgRolesData, nil
+}
+
+// GetUserOrgRoles returns the organization roles a user holds in the org
+func (c *Client) GetUserOrgRoles(ctx context.Context, userID, orgID string) ([]vra8.RoleResponse, error) {
+	const op = "get user org roles"
+	rsp, err := c.api.GetUserRolesInOrganizationWithResponse(ctx, userID, orgID)
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res []vra8.RoleResponse
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetUserServiceRoles returns the service roles a user holds in the org
+func (c *Client) GetUserServiceRoles(ctx context.Context, userID, orgID string) ([]vra8.UserServiceRole, error) {
+	const op = "get user service roles"
+	rsp, err := c.api.GetUserServiceRolesInOrganizationWithResponse(ctx, userID, orgID, nil)
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.UserServiceRoles
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	if res.ServiceRoles == nil {
+		return nil, nil
+	}
+	return *res.ServiceRoles, nil
+}
+
+// UpdateUserRoles applies a role patch through the v3 endpoint, which takes
+// organization and service role changes in one request
+func (c *Client) UpdateUserRoles(ctx context.Context, userID, orgID string, req vra8.UpdateMemberRolesRequest) error {
+	const op = "update user roles"
+	rsp, err := c.api.PatchUserRolesOnOrganizationWithResponse(ctx, userID, orgID, req)
+	if err != nil {
+		return transport(op, err)
+	}
+	return decode(op, rsp.StatusCode(), rsp.Body, nil)
+}
+
+// GetGroupRoles returns the org and service roles granted to a group
+func (c *Client) GetGroupRoles(ctx context.Context, orgID, groupID string) (*vra8.GroupRolesResponse, error) {
+	const op = "get group roles"
+	rsp, err := c.api.GetGroupRolesOnOrganizationWithResponse(ctx, orgID, groupID)
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.GroupRolesResponse
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateGroupRoles applies a role patch to a group
+func (c *Client) UpdateGroupRoles(ctx context.Context, orgID, groupID string, req vra8.UpdateGroupRolesRequest) error {
+	const op = "update group roles"
+	rsp, err := c.api.UpdateGroupRolesOnOrganizationWithResponse(ctx, orgID, groupID, req)
+	if err != nil {
+		return transport(op, err)
+	}
+	return decode(op, rsp.StatusCode(), rsp.Body, nil)
+}
@@ -0,0 +1,48 @@
+package iam
+
+import (
+	"context"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+)
+
+// expandFlag is a presence-only query parameter, the value is ignored
+var expandFlag = "true"
+
+// ListOrgUsers walks every page of the org's users, with their profile and
+// the group ids behind inherited roles
+func (c *Client) ListOrgUsers(ctx context.Context, orgID string) ([]vra8.ExpandedTypedUser, error) {
+	return collect[vra8.ExpandedTypedUser]("list org users", func(start, limit *int) (int, []byte, error) {
+		rsp, err := c.api.GetPaginatedOrgUsersInfoWithResponse(ctx, orgID, &vra8.GetPaginatedOrgUsersInfoParams{
+			PageStart:              start,
+			PageLimit:              limit,
+			ExpandProfile:          &expandFlag,
+			IncludeGroupIdsInRoles: &expandFlag,
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+		return rsp.StatusCode(), rsp.Body, nil
+	})
+}
+
+// SearchOrgUsers finds org users by a search term (name, email, username)
+func (c *Client) SearchOrgUsers(ctx context.Context, orgID, term string) ([]vra8.ExpandedTypedUser, error) {
+	const op = "search org users"
+	rsp, err := c.api.SearchUsersWithResponse(ctx, orgID, &vra8.SearchUsersParams{
+		UserSearchTerm:         term,
+		ExpandProfile:          &expandFlag,
+		IncludeGroupIdsInRoles: &expandFlag,
+	})
+	if err != nil {
+		return nil, transport(op, err)
+	}
+	var res vra8.SearchUsersResult
+	if err := decode(op, rsp.StatusCode(), rsp.Body, &res); err != nil {
+		return nil, err
+	}
+	if res.Results == nil {
+		return nil, nil
+	}
+	return *res.Results, nil
+}
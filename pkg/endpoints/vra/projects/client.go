@@ -0,0 +1,59 @@
+package projects
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"iv/pkg/apiversion"
+	"iv/pkg/rest"
+)
+
+// package projects is a client for the Project Service (/project-service/api)
+// which holds the project fields the IaaS view lacks: supervisors, properties,
+// cost and resource metadata
+
+const (
+	apiPrefix = "/project-service/api"
+	// pageSize is the size used when walking the project list
+	pageSize = 200
+)
+
+// APIService is the Project Service for apiVersion negotiation
+var APIService = apiversion.Service{Name: "project-service", Prefix: apiPrefix, Supported: []string{"2019-01-15"}}
+
+// Client is a typed view of the Project Service
+type Client struct {
+	rest *rest.Client
+}
+
+// New wraps an authenticated rest client
+func New(c *rest.Client) *Client {
+	return &Client{rest: c}
+}
+
+// page is the Spring page envelope of the Project Service
+type page[T any] struct {
+	Content []T  `json:"content"`
+	Last    bool `json:"last"`
+}
+
+// list walks 0-based page/size pages until the last one
+func list[T any](ctx context.Context, c *Client, path string, q url.Values) ([]T, error) {
+	var all []T
+	if q == nil {
+		q = url.Values{}
+	}
+	for n := 0; ; n++ {
+		q.Set("page", strconv.Itoa(n))
+		q.Set("size", strconv.Itoa(pageSize))
+		var p page[T]
+		if err := c.rest.Get(ctx, apiPrefix+path, q, &p); err != nil {
+			return nil, err
+		}
+		all = append(all, p.Content...)
+		if p.Last || len(p.Content) < pageSize {
+			return all, nil
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package projects
+
+import (
+	"context"
+	"net/url"
+)
+
+// Tag is a key:value tag of the Project Service
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// ResourceMetadata holds the tags applied to every compute resource
+// provisioned in a project
+type ResourceMetadata struct {
+	Tags []Tag `json:"tags"`
+}
+
+func (c *Client) GetResourceMetadata(ctx context.Context, id string) (*ResourceMetadata, error) {
+	var m ResourceMetadata
+	if err := c.rest.Get(ctx, apiPrefix+"/projects/"+url.PathEscape(id)+"/resource-metadata", nil, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// UpdateResourceMetadata replaces the resource tags of a project
+func (c *Client) UpdateResourceMetadata(ctx context.Context, id string, m ResourceMetadata) (*ResourceMetadata, error) {
+	if m.Tags == nil {
+		m.Tags = []Tag{}
+	}
+	var out ResourceMetadata
+	if err := c.rest.Patch(ctx, apiPrefix+"/projects/"+url.PathEscape(id)+"/resource-metadata", nil, m, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnionTags adds the tags of add that current lacks, existing tags are never
+// changed; it reports whether anything was added
+func UnionTags(current, add []Tag) ([]Tag, bool) {
+	out := append([]Tag(nil), current...)
+	have := map[Tag]bool{}
+	for _, t := range current {
+		have[t] = true
+	}
+	for _, t := range add {
+		if !have[t] {
+			have[t] = true
+			out = append(out, t)
+		}
+	}
+	return out, len(out) != len(current)
+}
+
+// UpdateCost sets the cost of a project and returns the project
+func (c *Client) UpdateCost(ctx context.Context, id string, cost Cost) (*Project, error) {
+	var p Project
+	if err := c.rest.Patch(ctx, apiPrefix+"/projects/"+url.PathEscape(id)+"/cost", nil, cost, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
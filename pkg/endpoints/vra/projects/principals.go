@@ -0,0 +1,155 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// roles a principal can have in a project
+const (
+	RoleAdministrator = "administrator"
+	RoleMember        = "member"
+	RoleViewer        = "viewer"
+	RoleSupervisor    = "supervisor"
+)
+
+// Roles are the project roles, most privileged first
+var Roles = []string{RoleAdministrator, RoleMember, RoleViewer, RoleSupervisor}
+
+// principal types, an empty type is a user
+const (
+	TypeUser  = "user"
+	TypeGroup = "group"
+)
+
+// PrincipalRole is a user or group with its role in a project
+type PrincipalRole struct {
+	ID    string `json:"id,omitempty"`
+	Email string `json:"email"`
+	Type  string `json:"type,omitempty"`
+	Role  string `json:"role,omitempty"`
+}
+
+// Key identifies a principal regardless of role, emails are case insensitive
+func (p PrincipalRole) Key() string {
+	t := p.Type
+	if t == "" {
+		t = TypeUser
+	}
+	return t + ":" + strings.ToLower(p.Email)
+}
+
+// PrincipalsAssignment adds, changes the role of, and removes principals
+type PrincipalsAssignment struct {
+	Modify []PrincipalRole `json:"modify,omitempty"`
+	Remove []PrincipalRole `json:"remove,omitempty"`
+}
+
+func (a PrincipalsAssignment) Empty() bool {
+	return len(a.Modify) == 0 && len(a.Remove) == 0
+}
+
+// ParseRole accepts a role in any case and the plural used by the project
+// fields, e.g. Members
+func ParseRole(s string) (string, error) {
+	r := strings.TrimSuffix(strings.ToLower(s), "s")
+	for _, known := range Roles {
+		if r == known {
+			return known, nil
+		}
+	}
+	return "", fmt.Errorf("unknown role %q (%s)", s, strings.Join(Roles, ", "))
+}
+
+// Principals flattens the role lists of a project, sorted by role then email
+func (p *Project) Principals() []PrincipalRole {
+	var out []PrincipalRole
+	for _, r := range Roles {
+		for _, pr := range p.principals(r) {
+			out = append(out, PrincipalRole{Email: pr.Email, Type: pr.Type, Role: r})
+		}
+	}
+	return out
+}
+
+func (p *Project) principals(role string) []Principal {
+	switch role {
+	case RoleAdministrator:
+		return p.Administrators
+	case RoleMember:
+		return p.Members
+	case RoleViewer:
+		return p.Viewers
+	case RoleSupervisor:
+		return p.Supervisors
+	}
+	return nil
+}
+
+// Reconcile is the assignment that turns the principals of p into want;
+// a principal keeps one role, when want lists it twice the most privileged
+// role wins
+func (p *Project) Reconcile(want []PrincipalRole) PrincipalsAssignment {
+	current := map[string]PrincipalRole{}
+	for _, pr := range p.Principals() {
+		if _, ok := current[pr.Key()]; !ok {
+			current[pr.Key()] = pr
+		}
+	}
+	desired := map[string]PrincipalRole{}
+	for _, pr := range want {
+		if have, ok := desired[pr.Key()]; !ok || rank(pr.Role) < rank(have.Role) {
+			desired[pr.Key()] = pr
+		}
+	}
+	var a PrincipalsAssignment
+	for k, pr := range desired {
+		if have, ok := current[k]; !ok || have.Role != pr.Role {
+			a.Modify = append(a.Modify, pr)
+		}
+	}
+	for k, pr := range current {
+		if _, ok := desired[k]; !ok {
+			a.Remove = append(a.Remove, pr)
+		}
+	}
+	sortPrincipals(a.Modify)
+	sortPrincipals(a.Remove)
+	return a
+}
+
+func rank(role string) int {
+	for i, r := range Roles {
+		if r == role {
+			return i
+		}
+	}
+	return len(Roles)
+}
+
+func sortPrincipals(all []PrincipalRole) {
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Role != all[j].Role {
+			return rank(all[i].Role) < rank(all[j].Role)
+		}
+		return all[i].Key() < all[j].Key()
+	})
+}
+
+// ModifyPrincipals applies an assignment and returns the changed project
+func (c *Client) ModifyPrincipals(ctx context.Context, id string, a PrincipalsAssignment) (*Project, error) {
+	var p Project
+	if err := c.rest.Patch(ctx, apiPrefix+"/projects/"+url.PathEscape(id)+"/principals", nil, a, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// SyncPrincipals makes the Project Service pick up principals changed in the
+// identity provider, e.g. renamed groups
+func (c *Client) SyncPrincipals(ctx context.Context, id string) error {
+	return c.rest.Post(ctx, apiPrefix+"/projects/"+url.PathEscape(id)+"/sync-principals", nil, nil, nil)
+}
@@ -0,0 +1,91 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// roles reads "role email" pairs, a group email is prefixed with group:
+func roles(s ...string) []PrincipalRole {
+	var out []PrincipalRole
+	for _, e := range s {
+		role, email, _ := strings.Cut(e, " ")
+		pr := PrincipalRole{Email: email, Role: role}
+		if g, ok := strings.CutPrefix(email, "group:"); ok {
+			pr.Email, pr.Type = g, TypeGroup
+		}
+		out = append(out, pr)
+	}
+	return out
+}
+
+func show(prs []PrincipalRole) string {
+	var s []string
+	for _, pr := range prs {
+		s = append(s, fmt.Sprintf("%s %s", pr.Role, pr.Key()))
+	}
+	return strings.Join(s, ", ")
+}
+
+func TestReconcile(t *testing.T) {
+	p := &Project{
+		Administrators: []Principal{{Email: "alice@corp"}},
+		Members:        []Principal{{Email: "bob@corp"}, {Email: "devs@corp", Type: TypeGroup}},
+		Viewers:        []Principal{{Email: "carol@corp"}},
+	}
+	same := roles("administrator alice@corp", "member bob@corp", "member group:devs@corp", "viewer carol@corp")
+
+	for _, tt := range []struct {
+		name           string
+		want           []PrincipalRole
+		modify, remove string
+	}{
+		{name: "in sync", want: same},
+		{name: "emails ignore case", want: roles("administrator ALICE@corp", "member Bob@Corp", "member group:devs@corp", "viewer carol@corp")},
+		{
+			name:   "add, change and remove",
+			want:   roles("administrator alice@corp", "viewer bob@corp", "member group:devs@corp", "supervisor dave@corp"),
+			modify: "viewer user:bob@corp, supervisor user:dave@corp",
+			remove: "viewer user:carol@corp",
+		},
+		{
+			name:   "a user is not the group of the same email",
+			want:   roles("administrator alice@corp", "member bob@corp", "member devs@corp", "viewer carol@corp"),
+			modify: "member user:devs@corp",
+			remove: "member group:devs@corp",
+		},
+		{
+			name: "the most privileged of duplicate roles wins",
+			want: append(roles("viewer alice@corp"), same...),
+		},
+		{
+			name:   "nothing wanted removes everyone, groups before users",
+			remove: "administrator user:alice@corp, member group:devs@corp, member user:bob@corp, viewer user:carol@corp",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			a := p.Reconcile(tt.want)
+			if got := show(a.Modify); got != tt.modify {
+				t.Errorf("modify = %q, want %q", got, tt.modify)
+			}
+			if got := show(a.Remove); got != tt.remove {
+				t.Errorf("remove = %q, want %q", got, tt.remove)
+			}
+			if a.Empty() != (tt.modify == "" && tt.remove == "") {
+				t.Errorf("Empty() = %v", a.Empty())
+			}
+		})
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	for in, want := range map[string]string{"Administrators": RoleAdministrator, "member": RoleMember, "VIEWER": RoleViewer, "supervisors": RoleSupervisor} {
+		if got, err := ParseRole(in); err != nil || got != want {
+			t.Errorf("ParseRole(%q) = %q, %v, want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseRole("owner"); err == nil {
+		t.Error("ParseRole accepted owner")
+	}
+}
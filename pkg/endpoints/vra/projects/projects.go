@@ -0,0 +1,76 @@
+package projects
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"iv/pkg/rest"
+)
+
+// Principal is a user or group of a project
+type Principal struct {
+	Email string `json:"email"`
+	Type  string `json:"type,omitempty"`
+}
+
+// Cost is the accumulated cost of a project
+type Cost struct {
+	Cost         float64 `json:"cost"`
+	CostSyncTime string  `json:"costSyncTime,omitempty"`
+	CostUnit     string  `json:"costUnit,omitempty"`
+	Message      string  `json:"message,omitempty"`
+	Code         string  `json:"code,omitempty"`
+}
+
+// Project is the Project Service view of a project
+type Project struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	OrgID            string            `json:"orgId,omitempty"`
+	Administrators   []Principal       `json:"administrators,omitempty"`
+	Members          []Principal       `json:"members,omitempty"`
+	Viewers          []Principal       `json:"viewers,omitempty"`
+	Supervisors      []Principal       `json:"supervisors,omitempty"`
+	Constraints      map[string]any    `json:"constraints,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+	Cost             *Cost             `json:"cost,omitempty"`
+	OperationTimeout int64             `json:"operationTimeout,omitempty"`
+	SharedResources  bool              `json:"sharedResources,omitempty"`
+}
+
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
+	return list[Project](ctx, c, "/projects", nil)
+}
+
+func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
+	var p Project
+	if err := c.rest.Get(ctx, apiPrefix+"/projects/"+url.PathEscape(id), nil, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+// FindProject returns a project by id, or by name when no project has that id
+func (c *Client) FindProject(ctx context.Context, ref string) (*Project, error) {
+	p, err := c.GetProject(ctx, ref)
+	if err == nil {
+		return p, nil
+	}
+	if rest.StatusCode(err) != http.StatusNotFound {
+		return nil, err
+	}
+	all, err := c.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range all {
+		if strings.EqualFold(all[i].Name, ref) {
+			return &all[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project %q not found", ref)
+}
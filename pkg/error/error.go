@@ -11,5 +11,10 @@ func (e *Error) Error() string {
 	return e.Err.Error()
 }
 
+// Unwrap lets errors.Is and errors.As look through to the cause
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 
 
@@ -0,0 +1,104 @@
+package events
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// package events reads the IaaS event log once or follows it like tail -f
+
+const (
+	// DefaultInterval is how often Follow polls
+	DefaultInterval = 10 * time.Second
+	// seenWindow is how long ids are remembered behind the cursor, entries
+	// that show up late with an older timestamp are still recognized
+	seenWindow = time.Hour
+)
+
+// Reader pages through the event log from a point in time on, every event
+// is returned once
+type Reader struct {
+	Client *iaas.Client
+	// Types limits events to these eventLogType values, all when empty
+	Types []string
+	// Interval between polls of Follow, DefaultInterval when 0
+	Interval time.Duration
+
+	cursor time.Time
+	seen   map[string]time.Time
+}
+
+// NewReader starts reading at since
+func NewReader(c *iaas.Client, since time.Time) *Reader {
+	return &Reader{Client: c, cursor: since, seen: map[string]time.Time{}}
+}
+
+func (r *Reader) filter() string {
+	f := "updatedAt ge " + iaas.TimeLiteral(r.cursor)
+	if len(r.Types) == 0 {
+		return f
+	}
+	var types []string
+	for _, t := range r.Types {
+		types = append(types, "eventLogType eq "+iaas.Quote(t))
+	}
+	return f + " and (" + strings.Join(types, " or ") + ")"
+}
+
+// Poll returns the events not returned before, oldest first
+func (r *Reader) Poll(ctx context.Context) ([]iaas.EventLog, error) {
+	all, err := r.Client.ListEventLogs(ctx, &iaas.ListOptions{Filter: r.filter()})
+	if err != nil {
+		return nil, err
+	}
+	var fresh []iaas.EventLog
+	for _, e := range all {
+		if _, dup := r.seen[e.ID]; dup {
+			continue
+		}
+		t := e.Time()
+		r.seen[e.ID] = t
+		fresh = append(fresh, e)
+		if t.After(r.cursor) {
+			r.cursor = t
+		}
+	}
+	for id, t := range r.seen {
+		if t.Before(r.cursor.Add(-seenWindow)) {
+			delete(r.seen, id)
+		}
+	}
+	sort.SliceStable(fresh, func(i, j int) bool { return fresh[i].Time().Before(fresh[j].Time()) })
+	return fresh, nil
+}
+
+// Follow polls until ctx is done and hands every new event to emit, a failed
+// poll is reported to onError and retried on the next tick
+func (r *Reader) Follow(ctx context.Context, emit func(iaas.EventLog) error, onError func(error)) error {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		fresh, err := r.Poll(ctx)
+		if err != nil && ctx.Err() == nil && onError != nil {
+			onError(err)
+		}
+		for _, e := range fresh {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-tick.C:
+		}
+	}
+}
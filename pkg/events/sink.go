@@ -0,0 +1,69 @@
+package events
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// Sink receives every event read, besides stdout
+type Sink interface {
+	Write(e iaas.EventLog) error
+	Close() error
+}
+
+// OpenSink opens a sink by spec: file:<path> appends JSON lines to a file,
+// syslog sends to the local syslog daemon
+func OpenSink(spec string) (Sink, error) {
+	switch {
+	case spec == "syslog":
+		return openSyslog()
+	case strings.HasPrefix(spec, "file:"):
+		path := strings.TrimPrefix(spec, "file:")
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonSink{w: f, c: f}, nil
+	}
+	return nil, fmt.Errorf("unknown sink %q, use file:<path> or syslog", spec)
+}
+
+// NewJSONLines writes one JSON object per line to w
+func NewJSONLines(w io.Writer) Sink {
+	return &jsonSink{w: w}
+}
+
+type jsonSink struct {
+	w io.Writer
+	c io.Closer
+}
+
+func (s *jsonSink) Write(e iaas.EventLog) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+func (s *jsonSink) Close() error {
+	if s.c == nil {
+		return nil
+	}
+	return s.c.Close()
+}
+
+// Line renders an event on one line for humans
+func Line(e iaas.EventLog) string {
+	ts := e.UpdatedAt
+	if t := e.Time(); !t.IsZero() {
+		ts = t.Local().Format("2006-01-02 15:04:05")
+	}
+	return fmt.Sprintf("%-19s  %-7s  %-24s  %s", ts, e.EventLogType, e.Owner, strings.ReplaceAll(e.Description, "\n", " "))
+}
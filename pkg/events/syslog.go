@@ -0,0 +1,38 @@
+//go:build !windows && !plan9
+
+package events
+
+import (
+	"log/syslog"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+type syslogSink struct {
+	w *syslog.Writer
+}
+
+func openSyslog() (Sink, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_USER, "iv-events")
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{w: w}, nil
+}
+
+// Write maps the event type to the syslog severity
+func (s *syslogSink) Write(e iaas.EventLog) error {
+	msg := Line(e)
+	switch strings.ToUpper(e.EventLogType) {
+	case "ERROR":
+		return s.w.Err(msg)
+	case "WARNING", "WARN":
+		return s.w.Warning(msg)
+	}
+	return s.w.Info(msg)
+}
+
+func (s *syslogSink) Close() error {
+	return s.w.Close()
+}
@@ -0,0 +1,9 @@
+//go:build windows || plan9
+
+package events
+
+import "errors"
+
+func openSyslog() (Sink, error) {
+	return nil, errors.New("syslog is not available on this platform, use file:<path>")
+}
@@ -0,0 +1,256 @@
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// Relation joins a kind to another through a reference field, a path that
+// starts with the relation name continues on the referenced resource, e.g.
+// project.name on a machine is the name of the project of its projectId
+type Relation struct {
+	Name  string
+	Field string
+	Kind  string
+	Key   string
+}
+
+// Relations are the joins queries can use
+var Relations = []Relation{
+	{"project", "projectId", "projects", "id"},
+	{"deployment", "deploymentId", "deployments", "id"},
+	{"region", "externalRegionId", "regions", "externalRegionId"},
+}
+
+// operators, longest first so >= is not read as >
+var operators = []string{"!=", ">=", "<=", "=", "~", ">", "<"}
+
+// Condition compares the values at a path with a value: = and != compare
+// case insensitively, ~ is a case insensitive substring and <, >, <=, >=
+// compare numbers, or strings when either side is not a number
+type Condition struct {
+	Path  string
+	Op    string
+	Value string
+}
+
+// ParseCondition reads path<op>value, e.g. customProperties.datastoreName~ssd
+func ParseCondition(s string) (Condition, error) {
+	at, op := -1, ""
+	for _, o := range operators {
+		if i := strings.Index(s, o); i > 0 && (at < 0 || i < at || (i == at && len(o) > len(op))) {
+			at, op = i, o
+		}
+	}
+	if at < 0 {
+		return Condition{}, fmt.Errorf("condition %q has no operator (%s)", s, strings.Join(operators, " "))
+	}
+	return Condition{Path: strings.TrimSpace(s[:at]), Op: op, Value: strings.TrimSpace(s[at+len(op):])}, nil
+}
+
+// Match reports whether one of values satisfies the condition, != holds
+// when none of them equals the value
+func (c Condition) Match(values []string) bool {
+	if c.Op == "!=" {
+		for _, v := range values {
+			if strings.EqualFold(v, c.Value) {
+				return false
+			}
+		}
+		return true
+	}
+	for _, v := range values {
+		if c.match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c Condition) match(v string) bool {
+	switch c.Op {
+	case "=":
+		return strings.EqualFold(v, c.Value)
+	case "~":
+		return strings.Contains(strings.ToLower(v), strings.ToLower(c.Value))
+	}
+	cmp := strings.Compare(v, c.Value)
+	a, errA := strconv.ParseFloat(v, 64)
+	b, errB := strconv.ParseFloat(c.Value, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case a < b:
+			cmp = -1
+		case a > b:
+			cmp = 1
+		default:
+			cmp = 0
+		}
+	}
+	switch c.Op {
+	case ">":
+		return cmp > 0
+	case "<":
+		return cmp < 0
+	case ">=":
+		return cmp >= 0
+	}
+	return cmp <= 0
+}
+
+// DB answers queries from a store, kinds are loaded when first used
+type DB struct {
+	store *Store
+	objs  map[string][]iaas.Object
+	keys  map[string]map[string]iaas.Object
+}
+
+func NewDB(s *Store) *DB {
+	return &DB{store: s, objs: map[string][]iaas.Object{}, keys: map[string]map[string]iaas.Object{}}
+}
+
+// All returns the resources of a kind
+func (db *DB) All(kind string) ([]iaas.Object, error) {
+	if objs, ok := db.objs[kind]; ok {
+		return objs, nil
+	}
+	if _, ok := LookupKind(kind); !ok {
+		return nil, fmt.Errorf("unknown kind %q (%s)", kind, strings.Join(KindNames(), ", "))
+	}
+	objs, err := db.store.Load(kind)
+	if err != nil {
+		return nil, err
+	}
+	db.objs[kind] = objs
+	return objs, nil
+}
+
+// Query returns the resources of a kind that match every condition
+func (db *DB) Query(kind string, where []Condition) ([]iaas.Object, error) {
+	objs, err := db.All(kind)
+	if err != nil {
+		return nil, err
+	}
+	var out []iaas.Object
+	for _, o := range objs {
+		ok := true
+		for _, c := range where {
+			values, err := db.Values(o, c.Path)
+			if err != nil {
+				return nil, err
+			}
+			if ok = c.Match(values); !ok {
+				break
+			}
+		}
+		if ok {
+			out = append(out, o)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool { return name(out[i]) < name(out[j]) })
+	return out, nil
+}
+
+// Values returns the values at a dotted path, following relations; lists
+// give one value per element and tags read as key:value
+func (db *DB) Values(o iaas.Object, path string) ([]string, error) {
+	head, rest, nested := strings.Cut(path, ".")
+	if _, own := o[head]; !own && nested {
+		for _, r := range Relations {
+			if r.Name != head {
+				continue
+			}
+			ref, _ := o[r.Field].(string)
+			if ref == "" {
+				return nil, nil
+			}
+			target, err := db.lookup(r.Kind, r.Key, ref)
+			if err != nil || target == nil {
+				return nil, err
+			}
+			return db.Values(target, rest)
+		}
+	}
+	return values(o[head], rest), nil
+}
+
+func (db *DB) lookup(kind, key, ref string) (iaas.Object, error) {
+	index := kind + "/" + key
+	if _, ok := db.keys[index]; !ok {
+		objs, err := db.All(kind)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[string]iaas.Object, len(objs))
+		for _, o := range objs {
+			if k, _ := o[key].(string); k != "" {
+				if _, dup := m[k]; !dup {
+					m[k] = o
+				}
+			}
+		}
+		db.keys[index] = m
+	}
+	return db.keys[index][ref], nil
+}
+
+func values(v any, path string) []string {
+	if path != "" {
+		head, rest, _ := strings.Cut(path, ".")
+		switch t := v.(type) {
+		case map[string]any:
+			return values(t[head], rest)
+		case []any:
+			var out []string
+			for _, e := range t {
+				out = append(out, values(e, path)...)
+			}
+			return out
+		}
+		return nil
+	}
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		return []string{t}
+	case float64:
+		return []string{strconv.FormatFloat(t, 'f', -1, 64)}
+	case []any:
+		var out []string
+		for _, e := range t {
+			out = append(out, values(e, "")...)
+		}
+		return out
+	case map[string]any:
+		if k, ok := t["key"].(string); ok {
+			val, _ := t["value"].(string)
+			return []string{k + ":" + val}
+		}
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+	return []string{fmt.Sprint(v)}
+}
+
+// KindNames lists the names of the kinds
+func KindNames() []string {
+	names := make([]string, 0, len(Kinds))
+	for _, k := range Kinds {
+		names = append(names, k.Name)
+	}
+	return names
+}
+
+func name(o iaas.Object) string {
+	s, _ := o["name"].(string)
+	return s
+}
@@ -0,0 +1,57 @@
+package inventory
+
+import "testing"
+
+func TestParseCondition(t *testing.T) {
+	want := map[string]Condition{
+		"name=web":       {Path: "name", Op: "=", Value: "web"},
+		" name != web ":  {Path: "name", Op: "!=", Value: "web"},
+		"cpuCount>=4":    {Path: "cpuCount", Op: ">=", Value: "4"},
+		"cpuCount<=4":    {Path: "cpuCount", Op: "<=", Value: "4"},
+		"cpuCount>4":     {Path: "cpuCount", Op: ">", Value: "4"},
+		"tags.env~prod":  {Path: "tags.env", Op: "~", Value: "prod"},
+		"description=>x": {Path: "description", Op: "=", Value: ">x"},
+		"name=":          {Path: "name", Op: "="},
+	}
+	for s, c := range want {
+		got, err := ParseCondition(s)
+		if err != nil {
+			t.Errorf("ParseCondition(%q): %v", s, err)
+		} else if got != c {
+			t.Errorf("ParseCondition(%q) = %+v, want %+v", s, got, c)
+		}
+	}
+	for _, s := range []string{"name", "=web", ""} {
+		if _, err := ParseCondition(s); err == nil {
+			t.Errorf("ParseCondition(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestConditionMatch(t *testing.T) {
+	for _, tt := range []struct {
+		cond   string
+		values []string
+		match  bool
+	}{
+		{"name=WEB", []string{"web"}, true},
+		{"name=web", []string{"web-1"}, false},
+		{"name!=web", []string{"db", "WEB"}, false},
+		{"name!=web", nil, true},
+		{"datastore~SSD", []string{"vsan-ssd-01"}, true},
+		// numbers compare as numbers, not as strings
+		{"cpuCount>4", []string{"16"}, true},
+		{"cpuCount<=4", []string{"4"}, true},
+		{"cpuCount<4", []string{"10"}, false},
+		{"createdAt>=2024-01-01", []string{"2024-03-01T10:00:00Z"}, true},
+		{"cpuCount>4", nil, false},
+	} {
+		c, err := ParseCondition(tt.cond)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.Match(tt.values); got != tt.match {
+			t.Errorf("%s on %q = %v, want %v", tt.cond, tt.values, got, tt.match)
+		}
+	}
+}
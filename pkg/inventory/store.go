@@ -0,0 +1,155 @@
+package inventory
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"iv/pkg/config"
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// package inventory keeps a local copy of the IaaS resources of a profile,
+// one JSON lines file per kind plus an index, so questions across kinds can
+// be answered without a round trip to vRA per resource
+
+const indexFile = "index.json"
+
+// KindState is what the index records about the last sync of a kind
+type KindState struct {
+	Count int `json:"count"`
+	// UpdatedAt is the newest updatedAt seen, the next sync asks only for
+	// resources updated since
+	UpdatedAt string    `json:"updatedAt,omitempty"`
+	SyncedAt  time.Time `json:"syncedAt"`
+	Full      bool      `json:"full"`
+}
+
+// Index describes the content of a store
+type Index struct {
+	Server string                `json:"server"`
+	Kinds  map[string]*KindState `json:"kinds"`
+}
+
+// Store is the inventory of one profile on disk
+type Store struct {
+	dir string
+}
+
+// DefaultDir is ~/.iv/inventory/<profile>
+func DefaultDir(profile string) (string, error) {
+	base, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(base, "inventory", profile), nil
+}
+
+// Open returns the store in dir, which is created on the first write
+func Open(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Index reads the index, an empty store has an empty index
+func (s *Store) Index() (*Index, error) {
+	idx := &Index{Kinds: map[string]*KindState{}}
+	data, err := os.ReadFile(filepath.Join(s.dir, indexFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return idx, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, idx); err != nil {
+		return nil, fmt.Errorf("inventory index: %w", err)
+	}
+	if idx.Kinds == nil {
+		idx.Kinds = map[string]*KindState{}
+	}
+	return idx, nil
+}
+
+// SaveIndex writes the index
+func (s *Store) SaveIndex(idx *Index) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.write(indexFile, func(w io.Writer) error {
+		_, err := w.Write(append(data, '\n'))
+		return err
+	})
+}
+
+// Load reads the resources of a kind, a kind never synced has none
+func (s *Store) Load(kind string) ([]iaas.Object, error) {
+	f, err := os.Open(filepath.Join(s.dir, kind+".jsonl"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var objs []iaas.Object
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		var o iaas.Object
+		if err := json.Unmarshal(sc.Bytes(), &o); err != nil {
+			return nil, fmt.Errorf("%s.jsonl:%d: %w", kind, line, err)
+		}
+		objs = append(objs, o)
+	}
+	return objs, sc.Err()
+}
+
+// Save replaces the resources of a kind, sorted by id so files diff well
+func (s *Store) Save(kind string, objs []iaas.Object) error {
+	sort.Slice(objs, func(i, j int) bool { return id(objs[i]) < id(objs[j]) })
+	return s.write(kind+".jsonl", func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		for _, o := range objs {
+			if err := enc.Encode(o); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// write fills a temp file and renames it, readers never see half a file
+func (s *Store) write(name string, fill func(io.Writer) error) error {
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	err = fill(w)
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+}
+
+func id(o iaas.Object) string {
+	s, _ := o["id"].(string)
+	return s
+}
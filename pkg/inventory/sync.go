@@ -0,0 +1,186 @@
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// Kind is a collection kept in the inventory, Incremental collections
+// support $filter and are synced by updatedAt
+type Kind struct {
+	Name        string
+	Collection  string
+	Incremental bool
+}
+
+// Kinds are the collections an inventory holds, in sync order
+var Kinds = []Kind{
+	{"machines", "/machines", true},
+	{"networks", "/networks", false},
+	{"block-devices", "/block-devices", true},
+	{"deployments", "/deployments", true},
+	{"projects", "/projects", true},
+	{"zones", "/zones", false},
+	{"regions", "/regions", false},
+	{"fabric-computes", "/fabric-computes", true},
+}
+
+// LookupKind finds a kind by name
+func LookupKind(name string) (Kind, bool) {
+	for _, k := range Kinds {
+		if k.Name == name {
+			return k, true
+		}
+	}
+	return Kind{}, false
+}
+
+const (
+	ModeFull        = "full"
+	ModeIncremental = "incremental"
+)
+
+// Result is the outcome of syncing one kind
+type Result struct {
+	Kind     string        `json:"kind"`
+	Mode     string        `json:"mode"`
+	Fetched  int           `json:"fetched"`
+	Count    int           `json:"count"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Syncer copies collections of a vRA server into a store
+type Syncer struct {
+	Client *iaas.Client
+	Store  *Store
+	// Server is recorded in the index, a store of another server is
+	// synced in full
+	Server string
+	// Full ignores the index and reads every collection completely
+	Full bool
+	// OnResult is called after every kind
+	OnResult func(Result)
+}
+
+// Sync syncs the given kinds, all when none are given; the index is saved
+// after every kind so an interrupted sync keeps what it got
+func (s *Syncer) Sync(ctx context.Context, kinds []Kind) error {
+	if len(kinds) == 0 {
+		kinds = Kinds
+	}
+	idx, err := s.Store.Index()
+	if err != nil {
+		return err
+	}
+	full := s.Full || idx.Server != s.Server
+	if idx.Server != s.Server {
+		idx.Kinds = map[string]*KindState{}
+		idx.Server = s.Server
+	}
+	var errs []error
+	for _, k := range kinds {
+		start := time.Now()
+		r, state, err := s.syncKind(ctx, k, idx.Kinds[k.Name], full)
+		r.Duration = time.Since(start).Round(time.Millisecond)
+		if err == nil {
+			idx.Kinds[k.Name] = state
+			err = s.Store.SaveIndex(idx)
+		}
+		if err != nil {
+			r.Error = err.Error()
+			errs = append(errs, fmt.Errorf("%s: %w", k.Name, err))
+		}
+		if s.OnResult != nil {
+			s.OnResult(r)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *Syncer) syncKind(ctx context.Context, k Kind, prev *KindState, full bool) (Result, *KindState, error) {
+	r := Result{Kind: k.Name, Mode: ModeFull}
+	var objs []iaas.Object
+	var err error
+	if !full && k.Incremental && prev != nil && prev.UpdatedAt != "" {
+		r.Mode = ModeIncremental
+		objs, r.Fetched, err = s.incremental(ctx, k, prev)
+		if err != nil {
+			return r, nil, err
+		}
+	}
+	if objs == nil {
+		r.Mode = ModeFull
+		if objs, err = s.Client.ListObjects(ctx, k.Collection, nil); err != nil {
+			return r, nil, err
+		}
+		r.Fetched = len(objs)
+	}
+	if err := s.Store.Save(k.Name, objs); err != nil {
+		return r, nil, err
+	}
+	r.Count = len(objs)
+	state := &KindState{Count: len(objs), UpdatedAt: newest(objs), SyncedAt: time.Now().UTC(), Full: r.Mode == ModeFull}
+	return r, state, nil
+}
+
+// incremental merges the resources updated since the last sync into the
+// stored ones; nil means the result cannot be trusted (resources were
+// deleted, which updatedAt does not show) and a full sync is needed
+func (s *Syncer) incremental(ctx context.Context, k Kind, prev *KindState) ([]iaas.Object, int, error) {
+	stored, err := s.Store.Load(k.Name)
+	if err != nil {
+		return nil, 0, err
+	}
+	changed, err := s.Client.ListObjects(ctx, k.Collection, &iaas.ListOptions{Filter: "updatedAt ge " + iaas.Quote(prev.UpdatedAt)})
+	if err != nil {
+		return nil, 0, err
+	}
+	byID := make(map[string]int, len(stored))
+	for i, o := range stored {
+		byID[id(o)] = i
+	}
+	for _, o := range changed {
+		if i, ok := byID[id(o)]; ok {
+			stored[i] = o
+			continue
+		}
+		byID[id(o)] = len(stored)
+		stored = append(stored, o)
+	}
+	total, err := s.Client.Count(ctx, k.Collection, "")
+	if err != nil {
+		return nil, 0, err
+	}
+	if total != len(stored) {
+		return nil, len(changed), nil
+	}
+	if stored == nil {
+		stored = []iaas.Object{}
+	}
+	return stored, len(changed), nil
+}
+
+// newest returns the latest updatedAt of objs
+func newest(objs []iaas.Object) string {
+	var max string
+	var maxT time.Time
+	for _, o := range objs {
+		s, _ := o["updatedAt"].(string)
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			continue
+		}
+		if t.After(maxT) {
+			max, maxT = s, t
+		}
+	}
+	return max
+}
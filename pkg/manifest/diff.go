@@ -0,0 +1,123 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// FieldDiff is one field whose live value differs from the declared one
+type FieldDiff struct {
+	Path    string `json:"path"`
+	Live    any    `json:"live"`
+	Desired any    `json:"desired"`
+	// Immutable fields can only be changed by deleting the object
+	Immutable bool `json:"immutable,omitempty"`
+}
+
+// diffSpec compares the declared fields of desired with live, fields that are
+// not declared are not managed and never reported
+func diffSpec(k *Kind, live, desired map[string]any) []FieldDiff {
+	var out []FieldDiff
+	for _, field := range sortedKeys(desired) {
+		if field == "name" || contains(k.WriteOnly, field) {
+			continue
+		}
+		diffs := diffValue(field, live[field], desired[field], contains(k.Exact, field))
+		for i := range diffs {
+			diffs[i].Immutable = contains(k.Immutable, field)
+		}
+		out = append(out, diffs...)
+	}
+	return out
+}
+
+func diffValue(path string, live, desired any, exact bool) []FieldDiff {
+	switch d := desired.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return []FieldDiff{{Path: path, Live: live, Desired: desired}}
+		}
+		var out []FieldDiff
+		for _, key := range sortedKeys(d) {
+			out = append(out, diffValue(path+"."+key, l[key], d[key], exact)...)
+		}
+		if exact {
+			for _, key := range sortedKeys(l) {
+				if _, declared := d[key]; !declared {
+					out = append(out, FieldDiff{Path: path + "." + key, Live: l[key]})
+				}
+			}
+		}
+		return out
+	case []any:
+		l, _ := live.([]any)
+		if !sameElements(l, d) {
+			return []FieldDiff{{Path: path, Live: live, Desired: desired}}
+		}
+		return nil
+	case nil:
+		if live == nil {
+			return nil
+		}
+	default:
+		if live != nil && fmt.Sprint(live) == fmt.Sprint(desired) {
+			return nil
+		}
+	}
+	return []FieldDiff{{Path: path, Live: live, Desired: desired}}
+}
+
+// sameElements compares arrays ignoring order, live elements that are maps
+// only count the keys the desired elements declare
+func sameElements(live, desired []any) bool {
+	if len(live) != len(desired) {
+		return false
+	}
+	keys := map[string]bool{}
+	for _, e := range desired {
+		if m, ok := e.(map[string]any); ok {
+			for k := range m {
+				keys[k] = true
+			}
+		}
+	}
+	count := map[string]int{}
+	for _, e := range desired {
+		count[canonical(e, nil)]++
+	}
+	for _, e := range live {
+		c := canonical(e, keys)
+		if count[c] == 0 {
+			return false
+		}
+		count[c]--
+	}
+	return true
+}
+
+// canonical renders v as JSON, maps restricted to keys when keys is set
+func canonical(v any, keys map[string]bool) string {
+	if m, ok := v.(map[string]any); ok && len(keys) > 0 {
+		p := map[string]any{}
+		for k := range keys {
+			if e, ok := m[k]; ok {
+				p[k] = e
+			}
+		}
+		v = p
+	}
+	// encoding/json sorts map keys
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+package manifest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffValue(t *testing.T) {
+	tests := []struct {
+		name          string
+		live, desired any
+		exact         bool
+		want          []FieldDiff
+	}{
+		{
+			name: "equal scalars",
+			live: "a", desired: "a",
+		},
+		{
+			name: "numbers compare by value",
+			live: float64(2), desired: 2,
+		},
+		{
+			name: "changed scalar",
+			live: "a", desired: "b",
+			want: []FieldDiff{{Path: "f", Live: "a", Desired: "b"}},
+		},
+		{
+			name:    "missing live value",
+			desired: "b",
+			want:    []FieldDiff{{Path: "f", Desired: "b"}},
+		},
+		{
+			name: "null matches missing",
+		},
+		{
+			name: "declared null against a value",
+			live: "a",
+			want: []FieldDiff{{Path: "f", Live: "a"}},
+		},
+		{
+			name:    "nested map reports the changed key",
+			live:    map[string]any{"a": "1", "b": "2"},
+			desired: map[string]any{"a": "1", "b": "3"},
+			want:    []FieldDiff{{Path: "f.b", Live: "2", Desired: "3"}},
+		},
+		{
+			name:    "undeclared keys are ignored",
+			live:    map[string]any{"a": "1", "b": "2"},
+			desired: map[string]any{"a": "1"},
+		},
+		{
+			name:    "exact reports undeclared keys",
+			live:    map[string]any{"a": "1", "b": "2"},
+			desired: map[string]any{"a": "1"},
+			exact:   true,
+			want:    []FieldDiff{{Path: "f.b", Live: "2"}},
+		},
+		{
+			name:    "map against a scalar",
+			live:    "x",
+			desired: map[string]any{"a": "1"},
+			want:    []FieldDiff{{Path: "f", Live: "x", Desired: map[string]any{"a": "1"}}},
+		},
+		{
+			name:    "arrays ignore order",
+			live:    []any{"b", "a"},
+			desired: []any{"a", "b"},
+		},
+		{
+			name:    "arrays differ",
+			live:    []any{"a"},
+			desired: []any{"a", "b"},
+			want:    []FieldDiff{{Path: "f", Live: []any{"a"}, Desired: []any{"a", "b"}}},
+		},
+		{
+			name:    "array elements only compare declared keys",
+			live:    []any{map[string]any{"key": "env", "value": "prod", "id": "1"}},
+			desired: []any{map[string]any{"key": "env", "value": "prod"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffValue("f", tt.live, tt.desired, tt.exact)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
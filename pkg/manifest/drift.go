@@ -0,0 +1,94 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Unmanaged is a live object of a declared kind that no manifest declares
+type Unmanaged struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// Drift is the difference between manifests and vRA
+type Drift struct {
+	// Drifted objects exist but have fields that differ from the manifests
+	Drifted []*Change `json:"drifted"`
+	// Missing objects are declared but do not exist
+	Missing []*Change `json:"missing"`
+	// Unmanaged objects exist only in vRA, for the kinds the manifests use
+	Unmanaged []Unmanaged `json:"unmanaged"`
+	InSync    int         `json:"inSync"`
+}
+
+// Found reports whether anything drifted
+func (d *Drift) Found() bool {
+	return len(d.Drifted)+len(d.Missing)+len(d.Unmanaged) > 0
+}
+
+// CheckDrift compares the manifests with the live objects
+func CheckDrift(ctx context.Context, r *Resolver, objs []*Object) (*Drift, error) {
+	plan, err := Diff(ctx, r, objs)
+	if err != nil {
+		return nil, err
+	}
+	d := &Drift{Drifted: []*Change{}, Missing: []*Change{}, Unmanaged: []Unmanaged{}}
+	declared := map[string]bool{}
+	for _, ch := range plan.Changes {
+		switch ch.Action {
+		case ActionCreate:
+			d.Missing = append(d.Missing, ch)
+		case ActionUpdate:
+			d.Drifted = append(d.Drifted, ch)
+		default:
+			d.InSync++
+		}
+		if ch.ID != "" {
+			declared[ch.ID] = true
+		}
+	}
+
+	var used []string
+	seen := map[string]bool{}
+	for _, o := range objs {
+		if !seen[o.Kind] {
+			seen[o.Kind] = true
+			used = append(used, o.Kind)
+		}
+	}
+	sort.Strings(used)
+	for _, kind := range used {
+		live, err := r.List(ctx, kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range live {
+			if id := str(l["id"]); !declared[id] {
+				d.Unmanaged = append(d.Unmanaged, Unmanaged{Kind: kind, Name: str(l["name"]), ID: id})
+			}
+		}
+	}
+	return d, nil
+}
+
+// Print writes a human readable summary
+func (d *Drift) Print(w io.Writer) {
+	for _, ch := range d.Drifted {
+		fmt.Fprintf(w, "drifted   %s/%s (%s)\n", ch.Kind, ch.Name, ch.ID)
+		for _, f := range ch.Fields {
+			fmt.Fprintf(w, "    %s: declared %s, live %s\n", f.Path, show(f.Desired), show(f.Live))
+		}
+	}
+	for _, ch := range d.Missing {
+		fmt.Fprintf(w, "missing   %s/%s (%s)\n", ch.Kind, ch.Name, ch.Source)
+	}
+	for _, u := range d.Unmanaged {
+		fmt.Fprintf(w, "unmanaged %s/%s (%s)\n", u.Kind, u.Name, u.ID)
+	}
+	fmt.Fprintf(w, "%d in sync, %d drifted, %d missing, %d unmanaged\n",
+		d.InSync, len(d.Drifted), len(d.Missing), len(d.Unmanaged))
+}
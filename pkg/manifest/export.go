@@ -0,0 +1,136 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Export reads every live object of the given kinds and turns them into
+// manifests, server generated fields are dropped and ids of other objects
+// become refs where the object can be found
+func Export(ctx context.Context, r *Resolver, kindNames []string) ([]*Object, error) {
+	var out []*Object
+	for _, name := range kindNames {
+		k, ok := LookupKind(name)
+		if !ok || !k.Managed {
+			return nil, fmt.Errorf("unknown kind %q (supported: %s)", name, strings.Join(Aliases(), ", "))
+		}
+		live, err := r.List(ctx, k.Name)
+		if err != nil {
+			return nil, err
+		}
+		var objs []*Object
+		for _, l := range live {
+			// copy, the listing stays cached in the resolver
+			v, err := normalize(k.live(l))
+			if err != nil {
+				return nil, err
+			}
+			spec := v.(map[string]any)
+			delete(spec, "name")
+			for _, f := range k.WriteOnly {
+				delete(spec, f)
+			}
+			for path, kind := range k.Refs {
+				if err := r.toRefs(ctx, spec, strings.Split(path, "."), kind); err != nil {
+					return nil, err
+				}
+			}
+			prune(spec)
+			objs = append(objs, &Object{Kind: k.Name, Name: str(l["name"]), Spec: spec})
+		}
+		sort.SliceStable(objs, func(i, j int) bool { return objs[i].Name < objs[j].Name })
+		out = append(out, objs...)
+	}
+	return out, nil
+}
+
+// toRefs replaces the ids found at path with refs, a step ending in []
+// descends into every element of an array
+func (r *Resolver) toRefs(ctx context.Context, v any, path []string, kind string) error {
+	m, ok := v.(map[string]any)
+	if !ok || len(path) == 0 {
+		return nil
+	}
+	field, each := strings.CutSuffix(path[0], "[]")
+	rest := path[1:]
+	if each {
+		list, _ := m[field].([]any)
+		for i, e := range list {
+			if len(rest) > 0 {
+				if err := r.toRefs(ctx, e, rest, kind); err != nil {
+					return err
+				}
+				continue
+			}
+			ref, err := r.ref(ctx, kind, e)
+			if err != nil {
+				return err
+			}
+			list[i] = ref
+		}
+		return nil
+	}
+	if len(rest) > 0 {
+		return r.toRefs(ctx, m[field], rest, kind)
+	}
+	if _, ok := m[field]; !ok {
+		return nil
+	}
+	ref, err := r.ref(ctx, kind, m[field])
+	if err != nil {
+		return err
+	}
+	m[field] = ref
+	return nil
+}
+
+// ref turns an id into ref:<Kind>/<name>, unknown ids are kept
+func (r *Resolver) ref(ctx context.Context, kind string, v any) (any, error) {
+	id := str(v)
+	if id == "" {
+		return v, nil
+	}
+	objs, err := r.List(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range objs {
+		if str(o["id"]) != id {
+			continue
+		}
+		// region names repeat across accounts, the external id is what users know
+		name := str(o["name"])
+		if ext := str(o["externalRegionId"]); kind == "Region" && ext != "" {
+			name = ext
+		}
+		return refPrefix + kind + "/" + name, nil
+	}
+	return v, nil
+}
+
+// prune drops null values and empty maps and arrays, recursively
+func prune(m map[string]any) {
+	for k, v := range m {
+		switch t := v.(type) {
+		case nil:
+			delete(m, k)
+		case map[string]any:
+			prune(t)
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		case []any:
+			for _, e := range t {
+				if em, ok := e.(map[string]any); ok {
+					prune(em)
+				}
+			}
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package manifest
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// Kind describes how one type of IaaS object is read, written and compared
+type Kind struct {
+	Name string
+	// Alias is the plural used on the command line, e.g. flavor-profiles
+	Alias string
+	// Collection is the path under /iaas/api
+	Collection string
+	// Managed kinds can be declared, the others can only be referenced
+	Managed bool
+	// Order puts kinds in apply order, lower first
+	Order int
+	// UpdateMethod is PATCH, or PUT where the API has no PATCH
+	UpdateMethod string
+	// UpdateCollection sends updates to the collection with the id in the body
+	UpdateCollection bool
+	// Immutable fields cannot change once the object exists
+	Immutable []string
+	// CreateOnly fields are sent on create and dropped from updates
+	CreateOnly []string
+	// WriteOnly fields cannot be read back, they are sent but never diffed
+	WriteOnly []string
+	// Exact fields are maps compared key for key rather than declared keys only
+	Exact []string
+	// Refs maps id fields (dotted, [] steps into arrays) to the kind they point at
+	Refs map[string]string
+
+	// list replaces the paged listing of Collection
+	list func(ctx context.Context, r *Resolver) ([]iaas.Object, error)
+	// live turns a live object into the shape of the specification
+	live func(obj iaas.Object) map[string]any
+	// matches reports whether a live object has the given name
+	matches func(obj iaas.Object, name string) bool
+}
+
+// serverFields are generated by vRA and never part of a specification
+var serverFields = []string{
+	"id", "createdAt", "updatedAt", "owner", "ownerType", "orgId", "_links",
+	"externalRegionId", "externalZoneId", "cloudAccountId", "cloudAccountIds",
+}
+
+var kinds = map[string]*Kind{}
+
+func register(k *Kind) {
+	if k.live == nil {
+		k.live = specShape
+	}
+	if k.matches == nil {
+		k.matches = byName
+	}
+	kinds[k.Name] = k
+}
+
+func init() {
+	// referenced only
+	register(&Kind{Name: "Region", Collection: "/regions", matches: func(obj iaas.Object, name string) bool {
+		return byName(obj, name) || str(obj["externalRegionId"]) == name
+	}})
+	register(&Kind{Name: "CloudAccount", Collection: "/cloud-accounts"})
+	register(&Kind{Name: "FabricCompute", Collection: "/fabric-computes"})
+	register(&Kind{Name: "FabricNetwork", Collection: "/fabric-networks"})
+	register(&Kind{Name: "SecurityGroup", Collection: "/security-groups"})
+	register(&Kind{Name: "NetworkDomain", Collection: "/network-domains"})
+	register(&Kind{Name: "LoadBalancer", Collection: "/load-balancers"})
+
+	register(&Kind{
+		Name:         "Zone",
+		Alias:        "zones",
+		Collection:   "/zones",
+		Managed:      true,
+		Order:        10,
+		UpdateMethod: http.MethodPatch,
+		Immutable:    []string{"regionId"},
+		WriteOnly:    []string{"computeIds"},
+		Exact:        []string{"customProperties"},
+		Refs:         map[string]string{"regionId": "Region", "computeIds[]": "FabricCompute"},
+	})
+	register(&Kind{
+		Name:         "FlavorProfile",
+		Alias:        "flavor-profiles",
+		Collection:   "/flavor-profiles",
+		Managed:      true,
+		Order:        10,
+		UpdateMethod: http.MethodPatch,
+		Immutable:    []string{"regionId"},
+		CreateOnly:   []string{"regionId"},
+		Exact:        []string{"flavorMapping"},
+		Refs:         map[string]string{"regionId": "Region"},
+		live: func(obj iaas.Object) map[string]any {
+			spec := specShape(obj)
+			delete(spec, "flavorMappings")
+			if m, ok := obj["flavorMappings"].(map[string]any); ok {
+				spec["flavorMapping"] = pickValues(m["mapping"], "id", "name", "cpuCount", "memoryInMB")
+			}
+			return spec
+		},
+	})
+	register(&Kind{
+		Name:         "ImageProfile",
+		Alias:        "image-profiles",
+		Collection:   "/image-profiles",
+		Managed:      true,
+		Order:        10,
+		UpdateMethod: http.MethodPatch,
+		Immutable:    []string{"regionId"},
+		CreateOnly:   []string{"regionId"},
+		Exact:        []string{"imageMapping"},
+		Refs:         map[string]string{"regionId": "Region"},
+		live: func(obj iaas.Object) map[string]any {
+			spec := specShape(obj)
+			delete(spec, "imageMappings")
+			if m, ok := obj["imageMappings"].(map[string]any); ok {
+				spec["imageMapping"] = pickValues(m["mapping"], "id", "name", "externalId", "constraints", "cloudConfig")
+			}
+			return spec
+		},
+	})
+	register(&Kind{
+		Name:         "NetworkProfile",
+		Alias:        "network-profiles",
+		Collection:   "/network-profiles",
+		Managed:      true,
+		Order:        10,
+		UpdateMethod: http.MethodPatch,
+		Immutable:    []string{"regionId"},
+		WriteOnly:    []string{"isolationExternalFabricNetworkId", "externalIpBlockIds", "loadBalancerIds"},
+		Exact:        []string{"customProperties"},
+		Refs: map[string]string{
+			"regionId":                         "Region",
+			"fabricNetworkIds[]":               "FabricNetwork",
+			"securityGroupIds[]":               "SecurityGroup",
+			"loadBalancerIds[]":                "LoadBalancer",
+			"isolationNetworkDomainId":         "NetworkDomain",
+			"isolationExternalFabricNetworkId": "FabricNetwork",
+		},
+		live: func(obj iaas.Object) map[string]any {
+			spec := specShape(obj)
+			spec["fabricNetworkIds"] = anyList(iaas.LinkIDs(obj, "fabric-networks"))
+			spec["securityGroupIds"] = anyList(iaas.LinkIDs(obj, "security-groups"))
+			if id := iaas.LinkID(obj, "isolated-network-domain"); id != "" {
+				spec["isolationNetworkDomainId"] = id
+			}
+			return spec
+		},
+	})
+	register(&Kind{
+		Name:         "StorageProfile",
+		Alias:        "storage-profiles",
+		Collection:   "/storage-profiles",
+		Managed:      true,
+		Order:        10,
+		UpdateMethod: http.MethodPut,
+		Immutable:    []string{"regionId"},
+		WriteOnly:    []string{"diskTargetProperties"},
+		Exact:        []string{"diskProperties"},
+		Refs:         map[string]string{"regionId": "Region"},
+	})
+	register(&Kind{
+		Name:         "Project",
+		Alias:        "projects",
+		Collection:   "/projects",
+		Managed:      true,
+		Order:        20,
+		UpdateMethod: http.MethodPatch,
+		Exact:        []string{"customProperties"},
+		Refs:         map[string]string{"zoneAssignmentConfigurations[].zoneId": "Zone"},
+		list: func(ctx context.Context, r *Resolver) ([]iaas.Object, error) {
+			return r.listProjects(ctx)
+		},
+		live: func(obj iaas.Object) map[string]any {
+			spec := specShape(obj)
+			delete(spec, "zones")
+			if _, ok := obj["zones"]; ok {
+				spec["zoneAssignmentConfigurations"] = pick(obj["zones"], "zoneId", "priority",
+					"maxNumberInstances", "memoryLimitMB", "cpuLimit", "storageLimitGB")
+			}
+			return spec
+		},
+	})
+	register(&Kind{
+		Name:             "CustomNaming",
+		Alias:            "naming",
+		Collection:       "/naming",
+		Managed:          true,
+		Order:            30,
+		UpdateMethod:     http.MethodPut,
+		UpdateCollection: true,
+		Refs:             map[string]string{"projects[].projectId": "Project"},
+		list: func(ctx context.Context, r *Resolver) ([]iaas.Object, error) {
+			return r.c.ListNamingObjects(ctx)
+		},
+		live: func(obj iaas.Object) map[string]any {
+			spec := specShape(obj)
+			spec["projects"] = pick(obj["projects"], "projectId", "active", "defaultOrg")
+			spec["templates"] = pick(obj["templates"], "name", "resourceType", "uniqueName",
+				"resourceDefault", "startCounter", "incrementStep", "pattern", "staticPattern")
+			return spec
+		},
+	})
+
+}
+
+// LookupKind returns the kind registered under a name or alias
+func LookupKind(name string) (*Kind, bool) {
+	if k, ok := kinds[name]; ok {
+		return k, true
+	}
+	for _, k := range kinds {
+		if k.Alias != "" && k.Alias == name {
+			return k, true
+		}
+	}
+	return nil, false
+}
+
+// Aliases lists the command line names of the kinds that can be declared
+func Aliases() []string {
+	var names []string
+	for _, k := range kinds {
+		if k.Managed {
+			names = append(names, k.Alias)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// KindNames lists the kinds that can be declared in manifests
+func KindNames() []string {
+	var names []string
+	for n, k := range kinds {
+		if k.Managed {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// specShape drops server generated fields and adds regionId from the links
+func specShape(obj iaas.Object) map[string]any {
+	spec := map[string]any{}
+	for k, v := range obj {
+		spec[k] = v
+	}
+	for _, f := range serverFields {
+		delete(spec, f)
+	}
+	if id := iaas.LinkID(obj, "region"); id != "" {
+		spec["regionId"] = id
+	}
+	return spec
+}
+
+func byName(obj iaas.Object, name string) bool {
+	return str(obj["name"]) == name
+}
+
+func str(v any) string {
+	s, _ := v.(string)
+	return s
+}
+
+// pick keeps the given keys of every element of a list of objects
+func pick(v any, keys ...string) []any {
+	list, _ := v.([]any)
+	out := []any{}
+	for _, e := range list {
+		m, _ := e.(map[string]any)
+		p := map[string]any{}
+		for _, k := range keys {
+			if f, ok := m[k]; ok {
+				p[k] = f
+			}
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+// pickValues is pick for the values of a map, e.g. the entries of a profile
+// mapping keyed by their logical name
+func pickValues(v any, keys ...string) map[string]any {
+	m, _ := v.(map[string]any)
+	out := map[string]any{}
+	for name, e := range m {
+		out[name] = pick([]any{e}, keys...)[0]
+	}
+	return out
+}
+
+func anyList(s []string) []any {
+	out := make([]any, len(s))
+	for i, v := range s {
+		out[i] = v
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// refPrefix marks a string value as a reference to another object by name
+const refPrefix = "ref:"
+
+// parseRef splits ref:<Kind>/<name>
+func parseRef(s string) (kind, name string, ok bool) {
+	if !strings.HasPrefix(s, refPrefix) {
+		return "", "", false
+	}
+	kind, name, ok = strings.Cut(strings.TrimPrefix(s, refPrefix), "/")
+	return kind, name, ok && kind != "" && name != ""
+}
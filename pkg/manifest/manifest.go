@@ -0,0 +1,158 @@
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// package manifest describes vRA infrastructure configuration as YAML
+// documents and reconciles it with the live IaaS API
+//
+//	kind: Zone
+//	name: dc1-compute
+//	spec:
+//	  regionId: ref:Region/Datacenter:datacenter-3
+//	  placementPolicy: DEFAULT
+//	---
+//	kind: Project
+//	name: web
+//	spec:
+//	  zoneAssignmentConfigurations:
+//	    - zoneId: ref:Zone/dc1-compute
+//	      priority: 1
+//
+// spec holds the fields of the IaaS *Specification for the kind, any string
+// of the form ref:<Kind>/<name> is replaced by the id of that object
+
+// Object is one manifest document
+type Object struct {
+	Kind string         `yaml:"kind" json:"kind"`
+	Name string         `yaml:"name" json:"name"`
+	Spec map[string]any `yaml:"spec" json:"spec"`
+
+	// Source is file:document for error messages
+	Source string `yaml:"-" json:"-"`
+}
+
+// Ref is the kind/name pair identifying the object
+func (o *Object) Ref() string {
+	return o.Kind + "/" + o.Name
+}
+
+// Load reads manifests from files and directories (*.yaml, *.yml, recursive)
+func Load(paths ...string) ([]*Object, error) {
+	var files []string
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			files = append(files, p)
+			continue
+		}
+		err = filepath.WalkDir(p, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			ext := strings.ToLower(filepath.Ext(path))
+			if !d.IsDir() && (ext == ".yaml" || ext == ".yml") {
+				files = append(files, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+
+	var objs []*Object
+	seen := map[string]string{}
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		docs, err := Decode(bytes.NewReader(data), f)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range docs {
+			if prev, dup := seen[o.Ref()]; dup {
+				return nil, fmt.Errorf("%s: %s already declared in %s", o.Source, o.Ref(), prev)
+			}
+			seen[o.Ref()] = o.Source
+			objs = append(objs, o)
+		}
+	}
+	return objs, nil
+}
+
+// Decode reads every document of a multi-document YAML stream
+func Decode(r io.Reader, source string) ([]*Object, error) {
+	var objs []*Object
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	for i := 1; ; i++ {
+		o := &Object{}
+		err := dec.Decode(o)
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: document %d: %w", source, i, err)
+		}
+		if o.Kind == "" && o.Name == "" && o.Spec == nil {
+			continue
+		}
+		o.Source = fmt.Sprintf("%s:%d", source, i)
+		if _, ok := kinds[o.Kind]; !ok {
+			return nil, fmt.Errorf("%s: unknown kind %q (supported: %s)", o.Source, o.Kind, strings.Join(KindNames(), ", "))
+		}
+		if o.Name == "" {
+			return nil, fmt.Errorf("%s: name is required", o.Source)
+		}
+		// round trip through JSON so numbers and maps look like API responses
+		spec, err := normalize(o.Spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", o.Source, err)
+		}
+		o.Spec, _ = spec.(map[string]any)
+		if o.Spec == nil {
+			o.Spec = map[string]any{}
+		}
+		objs = append(objs, o)
+	}
+}
+
+// Encode writes objects as a multi-document YAML stream
+func Encode(w io.Writer, objs []*Object) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	for _, o := range objs {
+		if err := enc.Encode(o); err != nil {
+			return err
+		}
+	}
+	return enc.Close()
+}
+
+func normalize(v any) (any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	err = json.Unmarshal(data, &out)
+	return out, err
+}
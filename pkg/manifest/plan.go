@@ -0,0 +1,298 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionNone   = "none"
+)
+
+// Change is what apply will do to one declared object
+type Change struct {
+	Kind   string      `json:"kind"`
+	Name   string      `json:"name"`
+	ID     string      `json:"id,omitempty"`
+	Action string      `json:"action"`
+	Fields []FieldDiff `json:"fields,omitempty"`
+	// Pending refs point at declared objects that apply creates first
+	Pending []string `json:"pending,omitempty"`
+	Source  string   `json:"source"`
+
+	obj *Object
+}
+
+// Plan lists the changes for a set of manifests in apply order
+type Plan struct {
+	Changes []*Change `json:"changes"`
+
+	r        *Resolver
+	declared map[string]bool
+}
+
+// Diff compares every object with its live counterpart
+func Diff(ctx context.Context, r *Resolver, objs []*Object) (*Plan, error) {
+	ordered, err := Sort(objs)
+	if err != nil {
+		return nil, err
+	}
+	p := &Plan{r: r, declared: map[string]bool{}}
+	for _, o := range ordered {
+		p.declared[o.Ref()] = true
+	}
+	for _, o := range ordered {
+		ch, err := p.diff(ctx, o)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", o.Source, o.Ref(), err)
+		}
+		p.Changes = append(p.Changes, ch)
+	}
+	return p, nil
+}
+
+func (p *Plan) diff(ctx context.Context, o *Object) (*Change, error) {
+	k := kinds[o.Kind]
+	ch := &Change{Kind: o.Kind, Name: o.Name, Source: o.Source, obj: o}
+	live, err := p.r.Find(ctx, o.Kind, o.Name)
+	if err != nil {
+		return nil, err
+	}
+	desired, pending, err := p.r.resolve(ctx, o.Spec, p.declared)
+	if err != nil {
+		return nil, err
+	}
+	ch.Pending = pending
+	if live == nil {
+		ch.Action = ActionCreate
+		return ch, nil
+	}
+	ch.ID = str(live["id"])
+	ch.Fields = diffSpec(k, k.live(live), desired.(map[string]any))
+	ch.Action = ActionUpdate
+	if len(ch.Fields) == 0 {
+		ch.Action = ActionNone
+	}
+	return ch, nil
+}
+
+// Empty reports whether every object is in sync
+func (p *Plan) Empty() bool {
+	for _, ch := range p.Changes {
+		if ch.Action != ActionNone {
+			return false
+		}
+	}
+	return true
+}
+
+// Print writes a human readable diff
+func (p *Plan) Print(w io.Writer) {
+	for _, ch := range p.Changes {
+		switch ch.Action {
+		case ActionCreate:
+			fmt.Fprintf(w, "+ %s/%s\n", ch.Kind, ch.Name)
+			for _, ref := range ch.Pending {
+				fmt.Fprintf(w, "    after %s\n", ref)
+			}
+		case ActionUpdate:
+			fmt.Fprintf(w, "~ %s/%s (%s)\n", ch.Kind, ch.Name, ch.ID)
+			for _, f := range ch.Fields {
+				note := ""
+				if f.Immutable {
+					note = " (immutable)"
+				}
+				fmt.Fprintf(w, "    %s: %s -> %s%s\n", f.Path, show(f.Live), show(f.Desired), note)
+			}
+		default:
+			fmt.Fprintf(w, "  %s/%s unchanged\n", ch.Kind, ch.Name)
+		}
+	}
+}
+
+// Apply creates and updates objects in order, an object whose create or
+// update fails stops everything that refers to it
+func (p *Plan) Apply(ctx context.Context, c *iaas.Client, w io.Writer) error {
+	for _, ch := range p.Changes {
+		for _, f := range ch.Fields {
+			if f.Immutable {
+				return fmt.Errorf("%s/%s: %s cannot be changed, delete and recreate the object", ch.Kind, ch.Name, f.Path)
+			}
+		}
+	}
+	var errs []error
+	failed := map[string]bool{}
+	for _, ch := range p.Changes {
+		if ch.Action == ActionNone {
+			fmt.Fprintf(w, "%s/%s unchanged\n", ch.Kind, ch.Name)
+			continue
+		}
+		if dep := firstFailed(ch.obj, failed); dep != "" {
+			failed[ch.obj.Ref()] = true
+			errs = append(errs, fmt.Errorf("%s/%s: skipped, %s failed", ch.Kind, ch.Name, dep))
+			continue
+		}
+		if err := p.apply(ctx, c, ch); err != nil {
+			failed[ch.obj.Ref()] = true
+			errs = append(errs, fmt.Errorf("%s/%s: %w", ch.Kind, ch.Name, err))
+			continue
+		}
+		if ch.Action == ActionCreate {
+			fmt.Fprintf(w, "%s/%s created (%s)\n", ch.Kind, ch.Name, ch.ID)
+		} else {
+			fmt.Fprintf(w, "%s/%s configured\n", ch.Kind, ch.Name)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Plan) apply(ctx context.Context, c *iaas.Client, ch *Change) error {
+	k := kinds[ch.Kind]
+	// resolve again, pending refs exist now
+	v, pending, err := p.r.resolve(ctx, ch.obj.Spec, nil)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("unresolved refs %s", strings.Join(pending, ", "))
+	}
+	body := v.(map[string]any)
+	body["name"] = ch.Name
+	if ch.Action == ActionCreate {
+		obj, err := c.CreateObject(ctx, k.Collection, body)
+		if err != nil {
+			return err
+		}
+		ch.ID = str(obj["id"])
+		p.r.remember(ch.Kind, obj)
+		return nil
+	}
+	for _, f := range k.CreateOnly {
+		delete(body, f)
+	}
+	id := ch.ID
+	if k.UpdateCollection {
+		body["id"], id = ch.ID, ""
+	}
+	_, err = c.UpdateObject(ctx, k.UpdateMethod, k.Collection, id, body)
+	return err
+}
+
+func firstFailed(o *Object, failed map[string]bool) string {
+	for _, ref := range refs(o.Spec) {
+		if failed[ref] {
+			return ref
+		}
+	}
+	return ""
+}
+
+// Delete removes the live counterpart of every object, in reverse apply order
+func Delete(ctx context.Context, r *Resolver, c *iaas.Client, objs []*Object, w io.Writer) error {
+	ordered, err := Sort(objs)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for i := len(ordered) - 1; i >= 0; i-- {
+		o := ordered[i]
+		live, err := r.Find(ctx, o.Kind, o.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", o.Ref(), err))
+			continue
+		}
+		if live == nil {
+			fmt.Fprintf(w, "%s not found\n", o.Ref())
+			continue
+		}
+		id := str(live["id"])
+		if err := c.DeleteObject(ctx, kinds[o.Kind].Collection, id); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", o.Ref(), err))
+			continue
+		}
+		r.forget(o.Kind, id)
+		fmt.Fprintf(w, "%s deleted (%s)\n", o.Ref(), id)
+	}
+	return errors.Join(errs...)
+}
+
+// Sort orders objects so that every object comes after the declared objects
+// it refers to, otherwise by kind order and then as declared
+func Sort(objs []*Object) ([]*Object, error) {
+	index := map[string]int{}
+	for i, o := range objs {
+		index[o.Ref()] = i
+	}
+	deps := make([]map[int]bool, len(objs))
+	for i, o := range objs {
+		deps[i] = map[int]bool{}
+		for _, ref := range refs(o.Spec) {
+			if j, ok := index[ref]; ok && j != i {
+				deps[i][j] = true
+			}
+		}
+	}
+	done := make([]bool, len(objs))
+	var out []*Object
+	for len(out) < len(objs) {
+		var ready []int
+		for i := range objs {
+			if done[i] {
+				continue
+			}
+			blocked := false
+			for j := range deps[i] {
+				if !done[j] {
+					blocked = true
+					break
+				}
+			}
+			if !blocked {
+				ready = append(ready, i)
+			}
+		}
+		if len(ready) == 0 {
+			var cycle []string
+			for i, o := range objs {
+				if !done[i] {
+					cycle = append(cycle, o.Ref())
+				}
+			}
+			return nil, fmt.Errorf("reference cycle between %s", strings.Join(cycle, ", "))
+		}
+		sort.Slice(ready, func(a, b int) bool {
+			ka, kb := kinds[objs[ready[a]].Kind].Order, kinds[objs[ready[b]].Kind].Order
+			if ka != kb {
+				return ka < kb
+			}
+			return ready[a] < ready[b]
+		})
+		done[ready[0]] = true
+		out = append(out, objs[ready[0]])
+	}
+	return out, nil
+}
+
+// show renders a value on one line
+func show(v any) string {
+	if v == nil {
+		return "<none>"
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,205 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/endpoints/vra/projects"
+)
+
+// Resolver finds live objects by name, every collection is listed once
+type Resolver struct {
+	c     *iaas.Client
+	ps    *projects.Client
+	lists map[string][]iaas.Object
+}
+
+// NewResolver returns a resolver reading through c
+func NewResolver(c *iaas.Client) *Resolver {
+	return &Resolver{c: c, lists: map[string][]iaas.Object{}}
+}
+
+// WithProjectService adds the Project Service view of projects to the IaaS
+// one, fields only the Project Service has (supervisors, properties) become
+// part of the live state
+func (r *Resolver) WithProjectService(ps *projects.Client) *Resolver {
+	r.ps = ps
+	return r
+}
+
+// List returns every live object of a kind
+func (r *Resolver) List(ctx context.Context, kind string) ([]iaas.Object, error) {
+	k, ok := kinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown kind %q", kind)
+	}
+	if objs, ok := r.lists[kind]; ok {
+		return objs, nil
+	}
+	var objs []iaas.Object
+	var err error
+	if k.list != nil {
+		objs, err = k.list(ctx, r)
+	} else {
+		objs, err = r.c.ListObjects(ctx, k.Collection, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("list %s: %w", kind, err)
+	}
+	r.lists[kind] = objs
+	return objs, nil
+}
+
+// Find returns the live object of a kind with the given name, nil when there
+// is none and an error when the name is ambiguous
+func (r *Resolver) Find(ctx context.Context, kind, name string) (iaas.Object, error) {
+	objs, err := r.List(ctx, kind)
+	if err != nil {
+		return nil, err
+	}
+	var found iaas.Object
+	for _, o := range objs {
+		if !kinds[kind].matches(o, name) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("%s/%s is ambiguous, more than one object has that name", kind, name)
+		}
+		found = o
+	}
+	return found, nil
+}
+
+// Name returns the name of the live object of a kind with the given id
+func (r *Resolver) Name(ctx context.Context, kind, id string) (string, bool, error) {
+	objs, err := r.List(ctx, kind)
+	if err != nil {
+		return "", false, err
+	}
+	for _, o := range objs {
+		if str(o["id"]) == id {
+			return str(o["name"]), true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// remember records an object created during apply so later refs resolve to it
+func (r *Resolver) remember(kind string, obj iaas.Object) {
+	if _, ok := r.lists[kind]; ok {
+		r.lists[kind] = append(r.lists[kind], obj)
+	}
+}
+
+// forget drops a deleted object from the cached listing
+func (r *Resolver) forget(kind, id string) {
+	objs := r.lists[kind]
+	for i, o := range objs {
+		if str(o["id"]) == id {
+			r.lists[kind] = append(objs[:i:i], objs[i+1:]...)
+			return
+		}
+	}
+}
+
+// resolve returns a copy of v with every ref replaced by an id, refs to
+// declared objects that do not exist yet are left in place and returned
+// as pending
+func (r *Resolver) resolve(ctx context.Context, v any, declared map[string]bool) (any, []string, error) {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		var pending []string
+		for k, e := range t {
+			rv, p, err := r.resolve(ctx, e, declared)
+			if err != nil {
+				return nil, nil, err
+			}
+			out[k] = rv
+			pending = append(pending, p...)
+		}
+		return out, pending, nil
+	case []any:
+		out := make([]any, len(t))
+		var pending []string
+		for i, e := range t {
+			rv, p, err := r.resolve(ctx, e, declared)
+			if err != nil {
+				return nil, nil, err
+			}
+			out[i] = rv
+			pending = append(pending, p...)
+		}
+		return out, pending, nil
+	case string:
+		kind, name, ok := parseRef(t)
+		if !ok {
+			return t, nil, nil
+		}
+		if _, known := kinds[kind]; !known {
+			return nil, nil, fmt.Errorf("%s: unknown kind %q", t, kind)
+		}
+		obj, err := r.Find(ctx, kind, name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if obj != nil {
+			return str(obj["id"]), nil, nil
+		}
+		if declared[kind+"/"+name] {
+			return t, []string{kind + "/" + name}, nil
+		}
+		return nil, nil, fmt.Errorf("%s: no such object", t)
+	}
+	return v, nil, nil
+}
+
+// refs lists the kind/name of every ref in v
+func refs(v any) []string {
+	var out []string
+	switch t := v.(type) {
+	case map[string]any:
+		for _, e := range t {
+			out = append(out, refs(e)...)
+		}
+	case []any:
+		for _, e := range t {
+			out = append(out, refs(e)...)
+		}
+	case string:
+		if kind, name, ok := parseRef(t); ok {
+			out = append(out, kind+"/"+name)
+		}
+	}
+	return out
+}
+
+// listProjects lists IaaS projects and fills in the Project Service fields
+func (r *Resolver) listProjects(ctx context.Context) ([]iaas.Object, error) {
+	objs, err := r.c.ListObjects(ctx, kinds["Project"].Collection, nil)
+	if err != nil || r.ps == nil {
+		return objs, err
+	}
+	extra, err := r.ps.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]map[string]any{}
+	for _, p := range extra {
+		v, err := normalize(p)
+		if err != nil {
+			return nil, err
+		}
+		byID[p.ID] = v.(map[string]any)
+	}
+	for _, o := range objs {
+		for k, v := range byID[str(o["id"])] {
+			// cost changes on its own and is not configuration
+			if _, ok := o[k]; !ok && k != "cost" {
+				o[k] = v
+			}
+		}
+	}
+	return objs, nil
+}
@@ -0,0 +1,98 @@
+package naming
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// package naming renders custom naming templates the way vRA does, so the
+// names a template change produces can be seen before anything is deployed
+
+// variable matches ${...}, a body of only # is the counter padded to as many
+// digits as there are #
+var variable = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+// Render fills the variables of a pattern and puts counter in its ${###}
+func Render(pattern string, vars map[string]string, counter int64) (string, error) {
+	missing := map[string]bool{}
+	out := variable.ReplaceAllStringFunc(pattern, func(m string) string {
+		name := strings.TrimSpace(m[2 : len(m)-1])
+		if name != "" && strings.Trim(name, "#") == "" {
+			return fmt.Sprintf("%0*d", len(name), counter)
+		}
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		missing[name] = true
+		return m
+	})
+	if len(missing) > 0 {
+		var names []string
+		for n := range missing {
+			names = append(names, "${"+n+"}")
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("no value for %s", strings.Join(names, ", "))
+	}
+	return out, nil
+}
+
+// NextCounter is the counter the next name of a project gets: the current
+// counter of the project plus the step, or the start counter when the
+// template was not used in the project yet
+func NextCounter(t *iaas.NamingTemplate, projectID string) int64 {
+	step := Step(t)
+	for _, c := range t.Counters {
+		if c.ProjectID == projectID && (c.CNResourceType == "" || c.CNResourceType == t.ResourceType) {
+			return c.CurrentCounter + step
+		}
+	}
+	return t.StartCounter
+}
+
+// Step is the increment of a template, 1 when unset
+func Step(t *iaas.NamingTemplate) int64 {
+	if t.IncrementStep > 0 {
+		return t.IncrementStep
+	}
+	return 1
+}
+
+// Preview renders the next count names of pattern starting at counter
+func Preview(pattern string, vars map[string]string, counter, step int64, count int) ([]string, error) {
+	names := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		n, err := Render(pattern, vars, counter+int64(i)*step)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, n)
+	}
+	return names, nil
+}
+
+// Template returns the template of a naming for a resource type
+func Template(n *iaas.CustomNaming, resourceType string) (*iaas.NamingTemplate, bool) {
+	for i := range n.Templates {
+		if strings.EqualFold(n.Templates[i].ResourceType, resourceType) {
+			return &n.Templates[i], true
+		}
+	}
+	return nil, false
+}
+
+// ProjectVars are the template variables known from a project
+func ProjectVars(p *iaas.Project) map[string]string {
+	vars := map[string]string{
+		"project.name": p.Name,
+		"project.id":   p.ID,
+	}
+	for k, v := range p.CustomProperties {
+		vars["project.customProperties."+k] = v
+	}
+	return vars
+}
@@ -0,0 +1,81 @@
+package naming
+
+import (
+	"reflect"
+	"testing"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+func TestRender(t *testing.T) {
+	vars := map[string]string{"project.name": "web", "resource.name": "vm"}
+	for pattern, want := range map[string]string{
+		"${project.name}-${###}":         "web-007",
+		"${ project.name }${#}":          "web7",
+		"static":                         "static",
+		"${resource.name}-${#####}-x":    "vm-00007-x",
+		"${project.name}${project.name}": "webweb",
+	} {
+		got, err := Render(pattern, vars, 7)
+		if err != nil {
+			t.Errorf("Render(%q): %v", pattern, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("Render(%q) = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestRenderCounterWiderThanPattern(t *testing.T) {
+	got, err := Render("vm-${##}", nil, 1234)
+	if err != nil || got != "vm-1234" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestRenderMissing(t *testing.T) {
+	_, err := Render("${b}-${a}-${b}-${##}", map[string]string{}, 1)
+	if err == nil || err.Error() != "no value for ${a}, ${b}" {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestNextCounter(t *testing.T) {
+	tpl := &iaas.NamingTemplate{
+		ResourceType:  "COMPUTE",
+		StartCounter:  10,
+		IncrementStep: 5,
+		Counters: []iaas.NamingCounter{
+			{ProjectID: "p1", CNResourceType: "NETWORK", CurrentCounter: 100},
+			{ProjectID: "p1", CNResourceType: "COMPUTE", CurrentCounter: 20},
+			{ProjectID: "p2", CurrentCounter: 3},
+		},
+	}
+	if got := NextCounter(tpl, "p1"); got != 25 {
+		t.Errorf("p1: got %d, want 25", got)
+	}
+	if got := NextCounter(tpl, "p2"); got != 8 {
+		t.Errorf("p2, counter without a resource type: got %d, want 8", got)
+	}
+	if got := NextCounter(tpl, "p3"); got != 10 {
+		t.Errorf("unused project: got %d, want the start counter 10", got)
+	}
+	tpl.IncrementStep = 0
+	if got := NextCounter(tpl, "p1"); got != 21 {
+		t.Errorf("unset step: got %d, want 21", got)
+	}
+}
+
+func TestPreview(t *testing.T) {
+	names, err := Preview("${project.name}-${###}", map[string]string{"project.name": "web"}, 8, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"web-008", "web-010", "web-012"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("got %v, want %v", names, want)
+	}
+	if _, err := Preview("${nope}", nil, 1, 1, 2); err == nil {
+		t.Fatal("want an error for a missing variable")
+	}
+}
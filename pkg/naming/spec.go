@@ -0,0 +1,35 @@
+package naming
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"iv/pkg/endpoints/vra/iaas"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Load reads a custom naming from YAML or JSON, fields are the ones of the
+// API (name, projects, templates), unknown fields are an error
+func Load(r io.Reader) (*iaas.CustomNaming, error) {
+	var v any
+	if err := yaml.NewDecoder(r).Decode(&v); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	var n iaas.CustomNaming
+	if err := dec.Decode(&n); err != nil {
+		return nil, err
+	}
+	if n.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	return &n, nil
+}
@@ -0,0 +1,273 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+	"iv/pkg/endpoints/vra/iam"
+)
+
+const (
+	KindUser  = "user"
+	KindGroup = "group"
+)
+
+// RoleDelta is what has to be added and removed to reach the desired roles
+type RoleDelta struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+func (d RoleDelta) empty() bool {
+	return len(d.Add) == 0 && len(d.Remove) == 0
+}
+
+func delta(current, desired roleSet) RoleDelta {
+	return RoleDelta{Add: desired.minus(current), Remove: current.minus(desired)}
+}
+
+// Change is the role patch for one subject
+type Change struct {
+	Kind     string               `json:"kind"`
+	Name     string               `json:"name"`
+	ID       string               `json:"id"`
+	Org      RoleDelta            `json:"orgRoles"`
+	Services map[string]RoleDelta `json:"serviceRoles,omitempty"`
+}
+
+// Plan holds the changes needed in one org, subjects already in sync are omitted
+type Plan struct {
+	Org     string   `json:"org"`
+	Changes []Change `json:"changes"`
+}
+
+// Diff reads the current roles of every declared subject and computes the plan
+func Diff(ctx context.Context, c *iam.Client, orgID string, spec *Spec) (*Plan, error) {
+	plan := &Plan{Org: orgID}
+
+	var users []vra8.ExpandedTypedUser
+	if len(spec.Users) > 0 {
+		var err error
+		if users, err = c.ListOrgUsers(ctx, orgID); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range spec.Users {
+		id, err := resolveUser(users, s.User)
+		if err != nil {
+			return nil, err
+		}
+		orgRoles, err := c.GetUserOrgRoles(ctx, id, orgID)
+		if err != nil {
+			return nil, fmt.Errorf("user %s: %w", s.User, err)
+		}
+		svcRoles, err := c.GetUserServiceRoles(ctx, id, orgID)
+		if err != nil {
+			return nil, fmt.Errorf("user %s: %w", s.User, err)
+		}
+		currentOrg := roleSet{}
+		for _, r := range orgRoles {
+			currentOrg[iam.Str(r.Name)] = true
+		}
+		currentSvc := map[string]roleSet{}
+		for _, r := range svcRoles {
+			svc := iam.ServiceID(iam.Str(r.ServiceDefinitionLink))
+			currentSvc[svc] = serviceRoleNames(r.ServiceRoles, r.ServiceRoleNames)
+		}
+		plan.add(s, KindUser, id, currentOrg, currentSvc)
+	}
+
+	for _, s := range spec.Groups {
+		roles, err := c.GetGroupRoles(ctx, orgID, s.Group)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", s.Group, err)
+		}
+		currentOrg := roleSet{}
+		if roles.OrganizationRoles != nil {
+			for _, r := range *roles.OrganizationRoles {
+				currentOrg[iam.Str(r.Name)] = true
+			}
+		}
+		currentSvc := map[string]roleSet{}
+		if roles.ServiceRoles != nil {
+			for _, r := range *roles.ServiceRoles {
+				currentSvc[iam.Str(r.ServiceDefinitionId)] = serviceRoleNames(r.ServiceRoles, r.ServiceRoleNames)
+			}
+		}
+		plan.add(s, KindGroup, s.Group, currentOrg, currentSvc)
+	}
+	return plan, nil
+}
+
+func (p *Plan) add(s Subject, kind, id string, currentOrg roleSet, currentSvc map[string]roleSet) {
+	name := s.User
+	if kind == KindGroup {
+		name = s.Group
+	}
+	ch := Change{Kind: kind, Name: name, ID: id, Services: map[string]RoleDelta{}}
+	if s.orgRolesSet {
+		ch.Org = delta(currentOrg, newRoleSet(s.OrgRoles...))
+	}
+	for svc, roles := range s.ServiceRoles {
+		if d := delta(currentSvc[svc], newRoleSet(roles...)); !d.empty() {
+			ch.Services[svc] = d
+		}
+	}
+	if ch.Org.empty() && len(ch.Services) == 0 {
+		return
+	}
+	p.Changes = append(p.Changes, ch)
+}
+
+// Empty reports whether everything is already in sync
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Print writes a human readable diff
+func (p *Plan) Print(w io.Writer) {
+	if p.Empty() {
+		fmt.Fprintf(w, "org %s: roles are in sync\n", p.Org)
+		return
+	}
+	for _, ch := range p.Changes {
+		label := ch.Name
+		if ch.ID != ch.Name {
+			label = fmt.Sprintf("%s (%s)", ch.Name, ch.ID)
+		}
+		fmt.Fprintf(w, "%s %s\n", ch.Kind, label)
+		for _, r := range ch.Org.Add {
+			fmt.Fprintf(w, "  + org role %s\n", r)
+		}
+		for _, r := range ch.Org.Remove {
+			fmt.Fprintf(w, "  - org role %s\n", r)
+		}
+		for _, svc := range sortedKeys(ch.Services) {
+			for _, r := range ch.Services[svc].Add {
+				fmt.Fprintf(w, "  + service %s role %s\n", svc, r)
+			}
+			for _, r := range ch.Services[svc].Remove {
+				fmt.Fprintf(w, "  - service %s role %s\n", svc, r)
+			}
+		}
+	}
+}
+
+// Apply sends one minimal patch per changed subject, failures do not stop
+// the remaining subjects
+func (p *Plan) Apply(ctx context.Context, c *iam.Client) error {
+	var errs []error
+	for _, ch := range p.Changes {
+		var err error
+		switch ch.Kind {
+		case KindUser:
+			err = c.UpdateUserRoles(ctx, ch.ID, p.Org, ch.memberRequest())
+		case KindGroup:
+			err = c.UpdateGroupRoles(ctx, p.Org, ch.ID, ch.groupRequest())
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", ch.Kind, ch.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (ch Change) memberRequest() vra8.UpdateMemberRolesRequest {
+	var req vra8.UpdateMemberRolesRequest
+	if !ch.Org.empty() {
+		req.OrganizationRoles = &vra8.UpdateRolesRequestBaseRoleBinding{
+			RoleNamesToAdd:    optional(ch.Org.Add),
+			RoleNamesToRemove: optional(ch.Org.Remove),
+		}
+	}
+	if len(ch.Services) > 0 {
+		var svcs []vra8.UpdateServiceRolesRequest
+		for _, svc := range sortedKeys(ch.Services) {
+			d := ch.Services[svc]
+			svcs = append(svcs, vra8.UpdateServiceRolesRequest{
+				ServiceDefinitionId: &svc,
+				RoleNamesToAdd:      optional(d.Add),
+				RoleNamesToRemove:   optional(d.Remove),
+			})
+		}
+		req.ServiceRoles = &svcs
+	}
+	return req
+}
+
+func (ch Change) groupRequest() vra8.UpdateGroupRolesRequest {
+	var req vra8.UpdateGroupRolesRequest
+	if !ch.Org.empty() {
+		req.OrganizationRoles = &vra8.OrgRoleRequest{
+			RoleNamesToAdd:    optional(ch.Org.Add),
+			RoleNamesToRemove: optional(ch.Org.Remove),
+		}
+	}
+	if len(ch.Services) > 0 {
+		var svcs []vra8.OrgServiceRoleRequest
+		for _, svc := range sortedKeys(ch.Services) {
+			d := ch.Services[svc]
+			svcs = append(svcs, vra8.OrgServiceRoleRequest{
+				ServiceDefinitionId: &svc,
+				RoleNamesToAdd:      optional(d.Add),
+				RoleNamesToRemove:   optional(d.Remove),
+			})
+		}
+		req.ServiceRoles = &svcs
+	}
+	return req
+}
+
+// resolveUser accepts a user id, username, account or email
+func resolveUser(users []vra8.ExpandedTypedUser, ref string) (string, error) {
+	for _, u := range users {
+		if u.User == nil {
+			continue
+		}
+		id := iam.Str(u.User.UserId)
+		if id == ref ||
+			strings.EqualFold(iam.Str(u.User.Username), ref) ||
+			strings.EqualFold(iam.Str(u.User.Acct), ref) ||
+			strings.EqualFold(iam.Str(u.User.Email), ref) {
+			return id, nil
+		}
+	}
+	return "", fmt.Errorf("user %s is not a member of the org", ref)
+}
+
+func serviceRoleNames(bindings *[]vra8.ServiceRoleBinding, names *[]string) roleSet {
+	s := roleSet{}
+	if bindings != nil && len(*bindings) > 0 {
+		for _, b := range *bindings {
+			s[iam.Str(b.Name)] = true
+		}
+		return s
+	}
+	if names != nil {
+		for _, n := range *names {
+			s[n] = true
+		}
+	}
+	return s
+}
+
+func optional(names []string) *[]string {
+	if len(names) == 0 {
+		return nil
+	}
+	return &names
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
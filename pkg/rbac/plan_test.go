@@ -0,0 +1,77 @@
+package rbac
+
+import (
+	"strings"
+	"testing"
+)
+
+// planFor loads a one subject spec and plans it against the current roles
+func planFor(t *testing.T, yaml string, org roleSet, svc map[string]roleSet) *Plan {
+	t.Helper()
+	spec, err := Load(strings.NewReader(yaml))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	p := &Plan{Org: "o1"}
+	for _, s := range spec.Users {
+		p.add(s, KindUser, "u1", org, svc)
+	}
+	for _, s := range spec.Groups {
+		p.add(s, KindGroup, s.Group, org, svc)
+	}
+	return p
+}
+
+func printed(p *Plan) string {
+	var b strings.Builder
+	p.Print(&b)
+	return b.String()
+}
+
+func TestPlanInSync(t *testing.T) {
+	p := planFor(t, "users:\n- user: alice\n  orgRoles: [org_member]\n  serviceRoles:\n    svc: [viewer]\n",
+		newRoleSet("org_member"), map[string]roleSet{"svc": newRoleSet("viewer"), "other": newRoleSet("admin")})
+	if !p.Empty() {
+		t.Fatalf("plan = %+v, want it empty", p.Changes)
+	}
+	if got, want := printed(p), "org o1: roles are in sync\n"; got != want {
+		t.Errorf("Print() = %q, want %q", got, want)
+	}
+}
+
+func TestPlanOrgRoles(t *testing.T) {
+	for _, tt := range []struct {
+		yaml string
+		want string
+	}{
+		{"users:\n- user: alice\n  orgRoles: [org_owner]\n", "user alice (u1)\n  + org role org_owner\n  - org role org_member\n"},
+		// an empty list removes every org role
+		{"users:\n- user: alice\n  orgRoles: []\n", "user alice (u1)\n  - org role org_member\n"},
+		// a missing key leaves them alone
+		{"users:\n- user: alice\n", "org o1: roles are in sync\n"},
+	} {
+		if got := printed(planFor(t, tt.yaml, newRoleSet("org_member"), nil)); got != tt.want {
+			t.Errorf("%s: Print() = %q, want %q", tt.yaml, got, tt.want)
+		}
+	}
+}
+
+func TestPlanServiceRoles(t *testing.T) {
+	p := planFor(t, "groups:\n- group: devs@corp\n  serviceRoles:\n    svc1: [admin, viewer]\n    svc2: []\n", nil,
+		map[string]roleSet{"svc1": newRoleSet("viewer", "user"), "svc2": newRoleSet("admin"), "svc3": newRoleSet("admin")})
+	want := "group devs@corp\n" +
+		"  + service svc1 role admin\n" +
+		"  - service svc1 role user\n" +
+		"  - service svc2 role admin\n"
+	if got := printed(p); got != want {
+		t.Errorf("Print() = %q, want %q", got, want)
+	}
+	ch := p.Changes[0]
+	if !ch.Org.empty() {
+		t.Errorf("org delta = %+v, want none without orgRoles", ch.Org)
+	}
+	req := ch.groupRequest()
+	if req.OrganizationRoles != nil || req.ServiceRoles == nil || len(*req.ServiceRoles) != 2 {
+		t.Errorf("group request = %+v, want two service patches and no org patch", req)
+	}
+}
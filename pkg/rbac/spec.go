@@ -0,0 +1,116 @@
+package rbac
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// package rbac reconciles org and service role assignments against a
+// desired state kept in a YAML file
+//
+//	org: 6b7a...            # optional, --org and the default org also work
+//	users:
+//	  - user: alice@corp.local   # user id, username or email
+//	    orgRoles: [org_member]
+//	    serviceRoles:
+//	      <service-definition-id>: [automationservice:cloud_admin]
+//	groups:
+//	  - group: 4f1e...           # group id
+//	    orgRoles: [org_member]
+//
+// only what is declared is managed: subjects missing from the file are left
+// alone, an omitted orgRoles key leaves org roles alone (orgRoles: [] removes
+// them all) and services missing from serviceRoles are left alone
+
+// Spec is the desired state
+type Spec struct {
+	Org    string    `yaml:"org,omitempty"`
+	Users  []Subject `yaml:"users,omitempty"`
+	Groups []Subject `yaml:"groups,omitempty"`
+}
+
+// Subject is a user or group and the roles it should hold
+type Subject struct {
+	User         string              `yaml:"user,omitempty"`
+	Group        string              `yaml:"group,omitempty"`
+	OrgRoles     []string            `yaml:"orgRoles"`
+	ServiceRoles map[string][]string `yaml:"serviceRoles,omitempty"`
+
+	// orgRolesSet records whether orgRoles was present, an empty list and a
+	// missing key mean different things
+	orgRolesSet bool
+}
+
+// UnmarshalYAML notes whether orgRoles was given
+func (s *Subject) UnmarshalYAML(n *yaml.Node) error {
+	type plain Subject
+	if err := n.Decode((*plain)(s)); err != nil {
+		return err
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		switch key := n.Content[i].Value; key {
+		case "orgRoles":
+			s.orgRolesSet = true
+		case "user", "group", "serviceRoles":
+		default:
+			return fmt.Errorf("line %d: unknown field %q", n.Content[i].Line, key)
+		}
+	}
+	return nil
+}
+
+// Load parses and validates a spec
+func Load(r io.Reader) (*Spec, error) {
+	spec := &Spec{}
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(spec); err != nil && err != io.EOF {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for i, u := range spec.Users {
+		if u.User == "" || u.Group != "" {
+			return nil, fmt.Errorf("users[%d]: set user (and not group)", i)
+		}
+		if seen["u:"+u.User] {
+			return nil, fmt.Errorf("users[%d]: %s declared twice", i, u.User)
+		}
+		seen["u:"+u.User] = true
+	}
+	for i, g := range spec.Groups {
+		if g.Group == "" || g.User != "" {
+			return nil, fmt.Errorf("groups[%d]: set group (and not user)", i)
+		}
+		if seen["g:"+g.Group] {
+			return nil, fmt.Errorf("groups[%d]: %s declared twice", i, g.Group)
+		}
+		seen["g:"+g.Group] = true
+	}
+	return spec, nil
+}
+
+// roleSet is a set of role names
+type roleSet map[string]bool
+
+func newRoleSet(names ...string) roleSet {
+	s := roleSet{}
+	for _, n := range names {
+		s[n] = true
+	}
+	return s
+}
+
+// minus returns the sorted names in s that are not in o
+func (s roleSet) minus(o roleSet) []string {
+	var out []string
+	for n := range s {
+		if !o[n] {
+			out = append(out, n)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,204 @@
+package report
+
+import (
+	"context"
+	"strings"
+
+	vra8 "iv/pkg/endpoints/vra/auth"
+	"iv/pkg/endpoints/vra/iam"
+)
+
+const (
+	SourceDirect = "direct"
+	SourceGroup  = "group"
+	SourceNested = "nested-group"
+
+	// maxNesting stops walking directory groups that nest absurdly deep (or loop)
+	maxNesting = 8
+)
+
+// AccessRow is one role held by one user and where it comes from
+type AccessRow struct {
+	Org      string `json:"org"`
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Kind     string `json:"kind"`
+	Service  string `json:"service,omitempty"`
+	Role     string `json:"role"`
+	Source   string `json:"source"`
+	// Via is the group path granting the role, e.g. "vra-admins > dc-ops"
+	Via string `json:"via,omitempty"`
+}
+
+// AccessHeader names the CSV columns of AccessRow.Strings
+var AccessHeader = []string{"org", "user id", "username", "email", "kind", "service", "role", "source", "via"}
+
+func (r AccessRow) Strings() []string {
+	return []string{r.Org, r.UserID, r.Username, r.Email, r.Kind, r.Service, r.Role, r.Source, r.Via}
+}
+
+// Access lists every user-role pair of the org, resolving group grants down
+// through nested directory groups to the group the user is really in
+func Access(ctx context.Context, c *iam.Client, orgID string) ([]AccessRow, error) {
+	users, err := c.ListOrgUsers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := c.ListOrgGroups(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]string{}
+	for _, g := range groups {
+		names[iam.Str(g.Id)] = iam.Str(g.DisplayName)
+	}
+	m := &membership{c: c, org: orgID, names: names, paths: map[string]map[string][]string{}}
+
+	var rows []AccessRow
+	for _, u := range users {
+		if u.User == nil {
+			continue
+		}
+		base := AccessRow{
+			Org:      orgID,
+			UserID:   iam.Str(u.User.UserId),
+			Username: iam.Str(u.User.Username),
+			Email:    iam.Str(u.User.Email),
+		}
+		if u.OrganizationRoles != nil {
+			for _, b := range *u.OrganizationRoles {
+				row := base
+				row.Kind, row.Role = "org", iam.Str(b.Name)
+				direct := b.MembershipType == nil || *b.MembershipType == vra8.OrganizationRoleBindingWithGroupsMembershipTypeDIRECT
+				more, err := m.rows(ctx, row, direct, b.GroupIds)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, more...)
+			}
+		}
+		if u.ServiceRoles != nil {
+			for _, svc := range *u.ServiceRoles {
+				if svc.ServiceRoles == nil {
+					continue
+				}
+				for _, b := range *svc.ServiceRoles {
+					row := base
+					row.Kind, row.Service, row.Role = "service", svc.ServiceDefinitionId, iam.Str(b.Name)
+					direct := b.MembershipType == nil || *b.MembershipType == vra8.DIRECT
+					more, err := m.rows(ctx, row, direct, b.GroupIds)
+					if err != nil {
+						return nil, err
+					}
+					rows = append(rows, more...)
+				}
+			}
+		}
+	}
+	return rows, nil
+}
+
+// membership resolves how a user belongs to an org group, lazily per group
+type membership struct {
+	c     *iam.Client
+	org   string
+	names map[string]string
+	// paths[groupID][userID] is the chain of group names from the org group
+	// down to the group the user is a direct member of
+	paths map[string]map[string][]string
+}
+
+// rows expands one role binding into its direct and per group rows
+func (m *membership) rows(ctx context.Context, row AccessRow, direct bool, groupIDs *[]string) ([]AccessRow, error) {
+	var out []AccessRow
+	if direct || groupIDs == nil || len(*groupIDs) == 0 {
+		r := row
+		r.Source = SourceDirect
+		out = append(out, r)
+	}
+	if groupIDs == nil {
+		return out, nil
+	}
+	for _, gid := range *groupIDs {
+		paths, err := m.resolve(ctx, gid)
+		if err != nil {
+			return nil, err
+		}
+		r := row
+		r.Source = SourceGroup
+		path, ok := paths[row.UserID]
+		if !ok {
+			path = []string{m.name(gid)}
+		}
+		if len(path) > 1 {
+			r.Source = SourceNested
+		}
+		r.Via = strings.Join(path, " > ")
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (m *membership) name(id string) string {
+	if n := m.names[id]; n != "" {
+		return n
+	}
+	return id
+}
+
+// resolve walks the group and its nested groups breadth first, so each
+// user gets the shortest path
+func (m *membership) resolve(ctx context.Context, groupID string) (map[string][]string, error) {
+	if p, ok := m.paths[groupID]; ok {
+		return p, nil
+	}
+	paths := map[string][]string{}
+	type level struct {
+		id   string
+		path []string
+	}
+	queue := []level{{id: groupID, path: []string{m.name(groupID)}}}
+	seen := map[string]bool{groupID: true}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		users, err := m.c.ListGroupUsers(ctx, m.org, cur.id, true)
+		if err != nil {
+			if len(cur.path) == 1 {
+				return nil, err
+			}
+			// nested directory groups are not always readable, the
+			// grant still shows up against the parent group
+			continue
+		}
+		for _, u := range users {
+			id := iam.Str(u.UserId)
+			if _, ok := paths[id]; !ok {
+				paths[id] = cur.path
+			}
+		}
+		if len(cur.path) >= maxNesting {
+			continue
+		}
+		nested, err := m.c.ListNestedGroups(ctx, m.org, cur.id)
+		if err != nil {
+			continue
+		}
+		for _, n := range nested {
+			nid := iam.Str(n.Id)
+			if nid == "" || seen[nid] {
+				continue
+			}
+			seen[nid] = true
+			name := iam.Str(n.DisplayName)
+			if name == "" {
+				name = nid
+			}
+			path := append(append([]string(nil), cur.path...), name)
+			queue = append(queue, level{id: nid, path: path})
+		}
+	}
+	m.paths[groupID] = paths
+	return paths, nil
+}
@@ -0,0 +1,238 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// the custom properties vSphere computes and machines carry their size in
+const (
+	propCPUCount   = "cpuCount"
+	propMemoryInMB = "memoryInMB"
+)
+
+// ComputeCapacity is the size of a fabric compute against what its machines
+// use; totals are nil when the compute does not report them and already
+// apply the maximum allowed allocation percent
+type ComputeCapacity struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	Machines          int    `json:"machines"`
+	CPUTotal          *int   `json:"cpuTotal"`
+	CPUAllocated      int    `json:"cpuAllocated"`
+	MemoryTotalMB     *int   `json:"memoryTotalMB"`
+	MemoryAllocatedMB int    `json:"memoryAllocatedMB"`
+}
+
+// ZoneCapacity sums the computes of a zone and adds the storage of its region
+type ZoneCapacity struct {
+	ZoneID             string            `json:"zoneId"`
+	Zone               string            `json:"zone"`
+	Region             string            `json:"region"`
+	Computes           []ComputeCapacity `json:"computes"`
+	Machines           int               `json:"machines"`
+	CPUTotal           *int              `json:"cpuTotal"`
+	CPUAllocated       int               `json:"cpuAllocated"`
+	MemoryTotalMB      *int              `json:"memoryTotalMB"`
+	MemoryAllocatedMB  int               `json:"memoryAllocatedMB"`
+	StorageFreeGB      *float64          `json:"storageFreeGB"`
+	StorageAllocatedGB int               `json:"storageAllocatedGB"`
+	Datastores         int               `json:"datastores"`
+	StoragePolicies    int               `json:"storagePolicies"`
+}
+
+// CapacityHeader names the columns of CapacityRows
+var CapacityHeader = []string{"zone", "compute", "machines", "cpu", "cpu allocated", "cpu %", "memory GB", "memory allocated GB", "memory %",
+	"storage free GB", "storage allocated GB", "datastores", "storage policies"}
+
+// CapacityRows flattens zones into one row per compute followed by a zone
+// total row
+func CapacityRows(zones []ZoneCapacity) [][]string {
+	var rows [][]string
+	for _, z := range zones {
+		for _, c := range z.Computes {
+			row := append([]string{z.Zone, c.Name, strconv.Itoa(c.Machines)}, sizes(c.CPUTotal, c.CPUAllocated, c.MemoryTotalMB, c.MemoryAllocatedMB)...)
+			rows = append(rows, append(row, "", "", "", ""))
+		}
+		row := append([]string{z.Zone, "(total)", strconv.Itoa(z.Machines)}, sizes(z.CPUTotal, z.CPUAllocated, z.MemoryTotalMB, z.MemoryAllocatedMB)...)
+		free := ""
+		if z.StorageFreeGB != nil {
+			free = strconv.FormatFloat(*z.StorageFreeGB, 'f', -1, 64)
+		}
+		rows = append(rows, append(row, free, strconv.Itoa(z.StorageAllocatedGB), strconv.Itoa(z.Datastores), strconv.Itoa(z.StoragePolicies)))
+	}
+	return rows
+}
+
+// sizes are the cpu and memory columns, totals that are not known stay empty
+func sizes(cpu *int, cpuUsed int, memMB *int, memUsedMB int) []string {
+	out := []string{"", strconv.Itoa(cpuUsed), "", "", gb(memUsedMB), ""}
+	if cpu != nil {
+		out[0], out[2] = strconv.Itoa(*cpu), pct(cpuUsed, *cpu)
+	}
+	if memMB != nil {
+		out[3], out[5] = gb(*memMB), pct(memUsedMB, *memMB)
+	}
+	return out
+}
+
+func gb(mb int) string {
+	return strconv.FormatFloat(float64(mb)/1024, 'f', 1, 64)
+}
+
+func pct(used, total int) string {
+	if total <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%.1f", float64(used)*100/float64(total))
+}
+
+// Capacity builds the capacity of every zone matching filter: the computes
+// of a zone come from the zone, the machines and block devices of a compute
+// are the ones in its external zone and region, and the storage of a zone is
+// that of the vSphere datastores and storage policies of its region. A
+// compute in several zones counts in each of them.
+func Capacity(ctx context.Context, c *iaas.Client, filter string) ([]ZoneCapacity, error) {
+	zones, err := c.ListZones(ctx, &iaas.ListOptions{Filter: filter})
+	if err != nil {
+		return nil, fmt.Errorf("list zones: %w", err)
+	}
+	computes, err := c.ListFabricComputes(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list fabric computes: %w", err)
+	}
+	byID := map[string]iaas.FabricCompute{}
+	for _, fc := range computes {
+		byID[fc.ID] = fc
+	}
+	machines, err := c.ListMachines(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list machines: %w", err)
+	}
+	disks, err := c.ListBlockDevices(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list block devices: %w", err)
+	}
+	datastores, err := c.ListFabricVsphereDatastores(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list datastores: %w", err)
+	}
+	policies, err := c.ListFabricVsphereStoragePolicies(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list storage policies: %w", err)
+	}
+
+	var out []ZoneCapacity
+	for _, z := range zones {
+		members, err := c.ZoneComputes(ctx, z.ID)
+		if err != nil {
+			return nil, fmt.Errorf("zone %s computes: %w", z.Name, err)
+		}
+		zc := ZoneCapacity{ZoneID: z.ID, Zone: z.Name, Region: z.ExternalRegionID}
+		placed := map[string]bool{}
+		for _, m := range members {
+			// the zone answer may be abridged, the fabric list is complete
+			if full, ok := byID[m.ID]; ok {
+				m = full
+			}
+			cc := computeCapacity(m, machines)
+			zc.Computes = append(zc.Computes, cc)
+			zc.Machines += cc.Machines
+			zc.CPUAllocated += cc.CPUAllocated
+			zc.MemoryAllocatedMB += cc.MemoryAllocatedMB
+			zc.CPUTotal = addKnown(zc.CPUTotal, cc.CPUTotal)
+			zc.MemoryTotalMB = addKnown(zc.MemoryTotalMB, cc.MemoryTotalMB)
+			if m.ExternalZoneID != "" {
+				placed[m.ExternalRegionID+"/"+m.ExternalZoneID] = true
+			}
+		}
+		for _, d := range disks {
+			if placed[d.ExternalRegionID+"/"+d.ExternalZoneID] {
+				zc.StorageAllocatedGB += d.CapacityInGB
+			}
+		}
+		for _, ds := range datastores {
+			if !inRegion(ds.ExternalRegionID, ds.CloudAccountIDs, z) {
+				continue
+			}
+			zc.Datastores++
+			if free, err := strconv.ParseFloat(ds.FreeSizeGB, 64); err == nil {
+				sum := free
+				if zc.StorageFreeGB != nil {
+					sum += *zc.StorageFreeGB
+				}
+				zc.StorageFreeGB = &sum
+			}
+		}
+		for _, p := range policies {
+			if inRegion(p.ExternalRegionID, p.CloudAccountIDs, z) {
+				zc.StoragePolicies++
+			}
+		}
+		out = append(out, zc)
+	}
+	return out, nil
+}
+
+func computeCapacity(fc iaas.FabricCompute, machines []iaas.Machine) ComputeCapacity {
+	cc := ComputeCapacity{ID: fc.ID, Name: fc.Name}
+	if cpu, ok := intProp(fc.CustomProperties, propCPUCount); ok {
+		cpu = allowed(cpu, fc.MaximumAllowedCPUAllocationPercent)
+		cc.CPUTotal = &cpu
+	}
+	if mem, ok := intProp(fc.CustomProperties, propMemoryInMB); ok {
+		mem = allowed(mem, fc.MaximumAllowedMemoryAllocationPercent)
+		cc.MemoryTotalMB = &mem
+	}
+	if fc.ExternalZoneID == "" {
+		return cc
+	}
+	for _, m := range machines {
+		if m.ExternalZoneID != fc.ExternalZoneID || (m.ExternalRegionID != "" && m.ExternalRegionID != fc.ExternalRegionID) {
+			continue
+		}
+		cc.Machines++
+		cpu, _ := intProp(m.CustomProperties, propCPUCount)
+		mem, _ := intProp(m.CustomProperties, propMemoryInMB)
+		cc.CPUAllocated += cpu
+		cc.MemoryAllocatedMB += mem
+	}
+	return cc
+}
+
+// inRegion reports whether a datastore or policy is in the region of a zone,
+// and of its cloud account when both say
+func inRegion(region string, accounts []string, z iaas.Zone) bool {
+	if region != z.ExternalRegionID {
+		return false
+	}
+	return z.CloudAccountID == "" || len(accounts) == 0 || slices.Contains(accounts, z.CloudAccountID)
+}
+
+func intProp(props map[string]string, key string) (int, bool) {
+	v, err := strconv.Atoi(props[key])
+	return v, err == nil
+}
+
+// allowed applies a maximum allocation percent, 0 means no limit
+func allowed(total, percent int) int {
+	if percent <= 0 {
+		return total
+	}
+	return total * percent / 100
+}
+
+func addKnown(sum, v *int) *int {
+	if v == nil {
+		return sum
+	}
+	n := *v
+	if sum != nil {
+		n += *sum
+	}
+	return &n
+}
@@ -0,0 +1,31 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// package report builds flat, row oriented reports out of vRA data
+
+// utf8BOM makes Excel read the file as UTF-8 instead of the local codepage
+const utf8BOM = "\xef\xbb\xbf"
+
+// WriteCSV writes a header and rows as CSV
+// excel adds a byte order mark and CRLF line endings so the file opens
+// cleanly when double clicked in Excel
+func WriteCSV(w io.Writer, header []string, rows [][]string, excel bool) error {
+	if excel {
+		if _, err := io.WriteString(w, utf8BOM); err != nil {
+			return err
+		}
+	}
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = excel
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
@@ -0,0 +1,41 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// EmptyDeployments returns the deployments created before olderThan that no
+// machine, network, block device or load balancer refers to, each of these
+// collections is listed once (networks and load balancers have no $filter);
+// a listing cut short fails the report, a missing resource would otherwise
+// make its deployment look empty
+func EmptyDeployments(ctx context.Context, c *iaas.Client, deployments []iaas.Deployment, olderThan time.Time) ([]iaas.Deployment, error) {
+	used := map[string]bool{}
+	for _, coll := range iaas.DeploymentCollections {
+		objs, err := c.ListAllObjects(ctx, coll)
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", coll, err)
+		}
+		for _, o := range objs {
+			if id, _ := o["deploymentId"].(string); id != "" {
+				used[id] = true
+			}
+		}
+	}
+	var empty []iaas.Deployment
+	for _, d := range deployments {
+		if used[d.ID] {
+			continue
+		}
+		// undated deployments are kept, like undated snapshots
+		if created := d.Created(); created.IsZero() || created.After(olderThan) {
+			continue
+		}
+		empty = append(empty, d)
+	}
+	return empty, nil
+}
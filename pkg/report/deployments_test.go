@@ -0,0 +1,63 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/rest"
+)
+
+func TestEmptyDeployments(t *testing.T) {
+	// bodies by collection, networks and load balancers are not paged
+	serve := func(bodies map[string]string) *iaas.Client {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			coll := strings.TrimPrefix(r.URL.Path, "/iaas/api")
+			if coll == "/networks" || coll == "/load-balancers" {
+				if r.URL.Query().Has("$top") {
+					t.Errorf("%s was paged", coll)
+				}
+			} else if r.URL.Query().Get("$skip") != "0" {
+				w.Write([]byte(`{"content":[],"totalElements":1}`))
+				return
+			}
+			body, ok := bodies[coll]
+			if !ok {
+				body = `{"content":[],"totalElements":0}`
+			}
+			w.Write([]byte(body))
+		}))
+		t.Cleanup(srv.Close)
+		c, err := rest.NewClient(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return iaas.New(c)
+	}
+	deployments := []iaas.Deployment{{}, {}, {}}
+	for i, id := range []string{"used", "empty", "new"} {
+		deployments[i].ID, deployments[i].CreatedAt = id, "2024-01-01T00:00:00Z"
+	}
+	deployments[2].CreatedAt = "2024-06-01T00:00:00Z"
+	cutoff := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	c := serve(map[string]string{"/load-balancers": `[{"id":"lb","deploymentId":"used"}]`})
+	empty, err := EmptyDeployments(context.Background(), c, deployments, cutoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(empty) != 1 || empty[0].ID != "empty" {
+		t.Errorf("EmptyDeployments() = %+v, want only empty", empty)
+	}
+
+	// a networks page holding one of two networks must not pass for all of them
+	c = serve(map[string]string{"/networks": `{"content":[{"id":"n1"}],"totalElements":2}`})
+	if _, err := EmptyDeployments(context.Background(), c, deployments, cutoff); !errors.Is(err, iaas.ErrIncomplete) {
+		t.Errorf("EmptyDeployments() = %v, want ErrIncomplete", err)
+	}
+}
@@ -0,0 +1,103 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+const (
+	LevelOK       = "ok"
+	LevelWarning  = "warning"
+	LevelCritical = "critical"
+)
+
+// Thresholds are used percentages that raise a range to warning or critical
+type Thresholds struct {
+	Warning  float64
+	Critical float64
+}
+
+// Level classifies a used percentage
+func (t Thresholds) Level(usedPct float64) string {
+	switch {
+	case t.Critical > 0 && usedPct >= t.Critical:
+		return LevelCritical
+	case t.Warning > 0 && usedPct >= t.Warning:
+		return LevelWarning
+	}
+	return LevelOK
+}
+
+// IPUsageRow is the capacity of one IP range, the last row of IPUsage is the
+// total over all ranges
+type IPUsageRow struct {
+	RangeID   string  `json:"rangeId"`
+	Name      string  `json:"name"`
+	Start     string  `json:"start"`
+	End       string  `json:"end"`
+	Total     int     `json:"total"`
+	Allocated int     `json:"allocated"`
+	Available int     `json:"available"`
+	Released  int     `json:"released"`
+	UsedPct   float64 `json:"usedPct"`
+	Level     string  `json:"level"`
+}
+
+// IPUsageHeader names the columns of IPUsageRow.Strings
+var IPUsageHeader = []string{"range id", "name", "start", "end", "total", "allocated", "available", "released", "used %", "level"}
+
+func (r IPUsageRow) Strings() []string {
+	return []string{r.RangeID, r.Name, r.Start, r.End, strconv.Itoa(r.Total), strconv.Itoa(r.Allocated),
+		strconv.Itoa(r.Available), strconv.Itoa(r.Released), fmt.Sprintf("%.1f", r.UsedPct), r.Level}
+}
+
+// IPUsage reads the counters of every range (the list endpoint leaves them
+// out) and sums them up
+func IPUsage(ctx context.Context, c *iaas.Client, filter string, t Thresholds) ([]IPUsageRow, error) {
+	ranges, err := c.ListNetworkIPRanges(ctx, &iaas.ListOptions{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	var rows []IPUsageRow
+	total := IPUsageRow{Name: "total"}
+	for _, r := range ranges {
+		full, err := c.GetNetworkIPRange(ctx, r.ID)
+		if err != nil {
+			return nil, err
+		}
+		row := IPUsageRow{
+			RangeID:   full.ID,
+			Name:      full.Name,
+			Start:     full.StartIPAddress,
+			End:       full.EndIPAddress,
+			Total:     full.TotalNumberOfIPs,
+			Allocated: full.NumberOfAllocatedIPs,
+			Available: full.NumberOfAvailableIPs,
+			Released:  full.NumberOfReleasedIPs,
+		}
+		row.UsedPct, row.Level = used(row, t)
+		rows = append(rows, row)
+		total.Total += row.Total
+		total.Allocated += row.Allocated
+		total.Available += row.Available
+		total.Released += row.Released
+	}
+	total.UsedPct, total.Level = used(total, t)
+	return append(rows, total), nil
+}
+
+// used is the share of the range that is not available
+func used(r IPUsageRow, t Thresholds) (float64, string) {
+	size := r.Total
+	if size == 0 {
+		size = r.Allocated + r.Available + r.Released
+	}
+	if size == 0 {
+		return 0, LevelOK
+	}
+	pct := float64(size-r.Available) * 100 / float64(size)
+	return pct, t.Level(pct)
+}
@@ -0,0 +1,35 @@
+package report
+
+import (
+	"context"
+	"sort"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// OrphanedTags returns the tags no resource carries, usage comes from reading
+// the resources of every tagged collection once; tags only named in
+// constraints or capability matching are not counted as used
+func OrphanedTags(ctx context.Context, c *iaas.Client, filter string) ([]iaas.Tag, error) {
+	all, err := c.ListTags(ctx, &iaas.ListOptions{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	idx, err := c.IndexTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var orphans []iaas.Tag
+	for _, t := range all {
+		if !idx.Used(t) {
+			orphans = append(orphans, t)
+		}
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		if orphans[i].Key != orphans[j].Key {
+			return orphans[i].Key < orphans[j].Key
+		}
+		return orphans[i].Value < orphans[j].Value
+	})
+	return orphans, nil
+}
@@ -0,0 +1,53 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/rest"
+)
+
+func TestOrphanedTags(t *testing.T) {
+	bodies := map[string]string{
+		"/tags":            `{"content":[{"id":"1","key":"env","value":"prod"},{"id":"2","key":"env","value":"dev"},{"id":"3","key":"tier"},{"id":"4","key":"old"}],"totalElements":4}`,
+		"/machines":        `{"content":[{"id":"m1","tags":[{"key":"env","value":"prod"}]}],"totalElements":1}`,
+		"/security-groups": `[{"id":"sg1","tags":[{"key":"tier"}]}]`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		coll := strings.TrimPrefix(r.URL.Path, "/iaas/api")
+		body, ok := bodies[coll]
+		if skip := r.URL.Query().Get("$skip"); !ok || skip != "" && skip != "0" {
+			body = `{"content":[],"totalElements":0}`
+		}
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+	rc, err := rest.NewClient(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := iaas.New(rc)
+
+	orphans, err := OrphanedTags(context.Background(), c, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, o := range orphans {
+		got = append(got, o.Key+"="+o.Value)
+	}
+	if strings.Join(got, " ") != "env=dev old=" {
+		t.Errorf("OrphanedTags() = %v, want env=dev old=", got)
+	}
+
+	// a short listing fails instead of reporting tags in use as orphaned
+	bodies["/zones"] = `{"content":[],"totalElements":3}`
+	if _, err := OrphanedTags(context.Background(), c, ""); !errors.Is(err, iaas.ErrIncomplete) {
+		t.Errorf("OrphanedTags() = %v, want ErrIncomplete", err)
+	}
+}
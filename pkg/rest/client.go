@@ -0,0 +1,239 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	ierr "iv/pkg/error"
+)
+
+// package rest is a small untyped REST client for endpoints that have no
+// generated client yet, it follows the same shape as the oapi-codegen
+// clients under pkg/endpoints so the two can share doers and editors
+
+// RequestEditorFn is called right before a request is sent
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// HttpRequestDoer performs HTTP requests, *http.Client implements it
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client talks JSON to a single server
+type Client struct {
+	// Server is the base URL, paths are resolved relative to it
+	Server string
+
+	Client HttpRequestDoer
+
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// NewClient creates a Client with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	client := Client{
+		Server: server,
+	}
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient overrides the default doer
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithInsecureSkipVerify disables TLS verification, lab appliances
+// usually run with self-signed certificates
+func WithInsecureSkipVerify() ClientOption {
+	return func(c *Client) error {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		c.Client = &http.Client{Transport: tr}
+		return nil
+	}
+}
+
+// WithRequestEditorFn adds a callback that can mutate every request
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// WithBearerToken sets the Authorization header on every request
+func WithBearerToken(token string) ClientOption {
+	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// StatusError is returned when the server answers with a non 2xx status
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	msg := strings.TrimSpace(string(e.Body))
+	if len(msg) > 512 {
+		msg = msg[:512] + "..."
+	}
+	if msg == "" {
+		return e.Status
+	}
+	return fmt.Sprintf("%s: %s", e.Status, msg)
+}
+
+// StatusCode digs the HTTP status out of an error returned by the client,
+// 0 means the request never got an answer
+func StatusCode(err error) int {
+	var se *StatusError
+	if errors.As(err, &se) {
+		return se.StatusCode
+	}
+	return 0
+}
+
+// NewRequest builds a request for path relative to the server
+// body is marshalled as JSON unless it already is an io.Reader
+func (c *Client) NewRequest(ctx context.Context, method, path string, query url.Values, body any) (*http.Request, error) {
+	serverURL, err := url.Parse(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	operationPath := path
+	if strings.HasPrefix(operationPath, "/") {
+		operationPath = "." + operationPath
+	}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(query) > 0 {
+		values := queryURL.Query()
+		for k, vs := range query {
+			for _, v := range vs {
+				values.Add(k, v)
+			}
+		}
+		queryURL.RawQuery = values.Encode()
+	}
+
+	var bodyReader io.Reader
+	switch b := body.(type) {
+	case nil:
+	case io.Reader:
+		bodyReader = b
+	default:
+		buf, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, queryURL.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	if bodyReader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// Do sends the request and decodes a JSON response into out (when non nil)
+// the raw response is returned with its body already consumed
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body, out any) (*http.Response, error) {
+	req, err := c.NewRequest(ctx, method, path, query, body)
+	if err != nil {
+		return nil, err
+	}
+	return c.Send(req, out)
+}
+
+// Send is Do for a request built with NewRequest, for callers that need to
+// set headers of their own
+func (c *Client) Send(req *http.Request, out any) (*http.Response, error) {
+	op := req.Method + " " + req.URL.Path
+	rsp, err := c.Client.Do(req)
+	if err != nil {
+		return nil, &ierr.Error{Operation: op, Type: "transport", Err: err}
+	}
+	defer rsp.Body.Close()
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return rsp, &ierr.Error{Operation: op, Type: "transport", Err: err}
+	}
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		return rsp, &ierr.Error{
+			Operation: op,
+			Type:      "status",
+			Err:       &StatusError{StatusCode: rsp.StatusCode, Status: rsp.Status, Body: data},
+		}
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return rsp, &ierr.Error{Operation: op, Type: "decode", Err: err}
+		}
+	}
+	return rsp, nil
+}
+
+// Get is a shorthand for Do with GET
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out any) error {
+	_, err := c.Do(ctx, http.MethodGet, path, query, nil, out)
+	return err
+}
+
+// Post is a shorthand for Do with POST
+func (c *Client) Post(ctx context.Context, path string, query url.Values, body, out any) error {
+	_, err := c.Do(ctx, http.MethodPost, path, query, body, out)
+	return err
+}
+
+// Patch is a shorthand for Do with PATCH
+func (c *Client) Patch(ctx context.Context, path string, query url.Values, body, out any) error {
+	_, err := c.Do(ctx, http.MethodPatch, path, query, body, out)
+	return err
+}
+
+// Delete is a shorthand for Do with DELETE
+func (c *Client) Delete(ctx context.Context, path string, query url.Values, out any) error {
+	_, err := c.Do(ctx, http.MethodDelete, path, query, nil, out)
+	return err
+}
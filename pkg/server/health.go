@@ -0,0 +1,254 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"iv/pkg/config"
+	"iv/pkg/connect"
+	"iv/pkg/cron"
+	"iv/pkg/endpoints/vra/iaas"
+
+	"github.com/rs/zerolog"
+)
+
+// cloud account states tracked by the health monitor
+const (
+	StateUnknown   = "unknown"
+	StateHealthy   = "healthy"
+	StateUnhealthy = "unhealthy"
+	// StateError means the check itself could not run
+	StateError = "error"
+)
+
+const (
+	// historySize keeps a day of results at a 15 minute schedule
+	historySize = 96
+	// checkTimeout bounds one health check including its request tracker
+	checkTimeout = 5 * time.Minute
+)
+
+// HealthResult is the outcome of one check
+type HealthResult struct {
+	Time  time.Time `json:"time"`
+	State string    `json:"state"`
+	Error string    `json:"error,omitempty"`
+}
+
+// AccountHealth is the tracked state of one cloud account
+type AccountHealth struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Type  string `json:"type,omitempty"`
+	State string `json:"state"`
+	// Since is when the account entered its current state
+	Since     time.Time      `json:"since"`
+	CheckedAt time.Time      `json:"checkedAt"`
+	Error     string         `json:"error,omitempty"`
+	History   []HealthResult `json:"history"`
+}
+
+// HealthMonitor runs cloud account health checks on a schedule and keeps
+// the results, state changes go to the notifier
+type HealthMonitor struct {
+	Schedule cron.Schedule
+	// Accounts are names or ids, empty means every account
+	Accounts []string
+	Notifier Notifier
+	Logger   zerolog.Logger
+
+	// connect logs in anew for every run, access tokens expire
+	connect func(ctx context.Context) (*iaas.Client, error)
+
+	mu      sync.Mutex
+	status  map[string]*AccountHealth
+	lastRun time.Time
+}
+
+// NewHealthMonitor builds a monitor from the monitor section of the config
+func NewHealthMonitor(cfg *config.Config, lgr zerolog.Logger) (*HealthMonitor, error) {
+	m := cfg.Monitor
+	sched, err := cron.Parse(m.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	p, err := cfg.Active(m.Profile)
+	if err != nil {
+		return nil, err
+	}
+	hm := &HealthMonitor{
+		Schedule: sched,
+		Accounts: m.Accounts,
+		Logger:   lgr,
+		connect: func(ctx context.Context) (*iaas.Client, error) {
+			c, err := connect.Client(ctx, p, true, func(msg string) { lgr.Warn().Str("profile", p.Name).Msg(msg) })
+			if err != nil {
+				return nil, err
+			}
+			return iaas.New(c), nil
+		},
+		status: map[string]*AccountHealth{},
+	}
+	if m.Webhook != "" {
+		hm.Notifier = NewWebhookNotifier(m.Webhook)
+	}
+	return hm, nil
+}
+
+// Run checks once right away and then on every activation of the schedule
+// until ctx is done
+func (m *HealthMonitor) Run(ctx context.Context) {
+	for {
+		m.CheckAll(ctx)
+		next := m.Schedule.Next(time.Now())
+		if next.IsZero() {
+			m.Logger.Error().Msg("health monitor schedule has no next activation, stopping")
+			return
+		}
+		t := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// CheckAll runs a health check on every monitored account
+func (m *HealthMonitor) CheckAll(ctx context.Context) {
+	c, err := m.connect(ctx)
+	if err != nil {
+		m.Logger.Error().Err(err).Msg("health monitor cannot log in")
+		return
+	}
+	type target struct{ key, ref string }
+	var targets []target
+	if len(m.Accounts) == 0 {
+		accounts, err := c.ListCloudAccounts(ctx, nil)
+		if err != nil {
+			m.Logger.Error().Err(err).Msg("health monitor cannot list cloud accounts")
+			return
+		}
+		for _, a := range accounts {
+			targets = append(targets, target{key: a.ID, ref: a.ID})
+		}
+	}
+	for _, ref := range m.Accounts {
+		targets = append(targets, target{key: ref, ref: ref})
+	}
+	for _, t := range targets {
+		if ctx.Err() != nil {
+			return
+		}
+		m.check(ctx, c, t.key, t.ref)
+	}
+	m.mu.Lock()
+	m.lastRun = time.Now()
+	m.mu.Unlock()
+}
+
+func (m *HealthMonitor) check(ctx context.Context, c *iaas.Client, key, ref string) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	res := HealthResult{Time: time.Now(), State: StateError}
+	a, err := c.FindCloudAccount(ctx, ref)
+	if err == nil {
+		a, err = c.CheckHealth(ctx, a.ID)
+	}
+	switch {
+	case err != nil:
+		res.Error = err.Error()
+		a = &iaas.CloudAccount{Resource: iaas.Resource{Name: ref}}
+	case a.Healthy == nil:
+		res.State = StateUnknown
+	case *a.Healthy:
+		res.State = StateHealthy
+	default:
+		res.State = StateUnhealthy
+	}
+	m.record(ctx, key, a, res)
+}
+
+// record stores a result and notifies when the state changed, the first
+// result only notifies when it is not healthy
+func (m *HealthMonitor) record(ctx context.Context, key string, a *iaas.CloudAccount, res HealthResult) {
+	m.mu.Lock()
+	h, ok := m.status[key]
+	if !ok {
+		h = &AccountHealth{State: StateUnknown, Since: res.Time}
+		m.status[key] = h
+	}
+	if a.ID != "" {
+		h.ID, h.Name, h.Type = a.ID, a.Name, a.CloudAccountType
+	} else if h.Name == "" {
+		h.Name = a.Name
+	}
+	previous := h.State
+	if previous != res.State {
+		h.Since = res.Time
+	}
+	h.State, h.CheckedAt, h.Error = res.State, res.Time, res.Error
+	h.History = append(h.History, res)
+	if len(h.History) > historySize {
+		h.History = h.History[len(h.History)-historySize:]
+	}
+	tr := Transition{Account: h.Name, ID: h.ID, Type: h.Type, Previous: previous, Current: res.State, Error: res.Error, Time: res.Time}
+	m.mu.Unlock()
+
+	log := m.Logger.Info()
+	if res.State != StateHealthy {
+		log = m.Logger.Warn()
+	}
+	log.Str("account", h.Name).Str("state", res.State).Str("error", res.Error).Msg("cloud account health")
+
+	if previous == res.State || (previous == StateUnknown && res.State == StateHealthy) {
+		return
+	}
+	if m.Notifier == nil {
+		return
+	}
+	if err := m.Notifier.Notify(ctx, tr); err != nil {
+		m.Logger.Error().Err(err).Str("account", h.Name).Msg("health notification failed")
+	}
+}
+
+// Status returns a copy of the tracked accounts sorted by name
+func (m *HealthMonitor) Status() ([]AccountHealth, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]AccountHealth, 0, len(m.status))
+	for _, h := range m.status {
+		c := *h
+		c.History = append([]HealthResult(nil), h.History...)
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, m.lastRun
+}
+
+// handleCloudAccountHealth serves GET /api/health/cloud-accounts
+func (s *Server) handleCloudAccountHealth(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if s.Health == nil {
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "cloud account monitoring is not configured"})
+		return
+	}
+	accounts, lastRun := s.Health.Status()
+	rsp := struct {
+		LastRun  *time.Time      `json:"lastRun,omitempty"`
+		Accounts []AccountHealth `json:"accounts"`
+	}{Accounts: accounts}
+	if !lastRun.IsZero() {
+		rsp.LastRun = &lastRun
+	}
+	if err := json.NewEncoder(w).Encode(rsp); err != nil {
+		s.Logger.Error().Err(fmt.Errorf("encoding health status: %w", err)).Send()
+	}
+}
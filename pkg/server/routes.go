@@ -9,4 +9,8 @@ func (s *Server) registerRoutes() {
 		s.loggerChain().
 			Append(s.authHandler).
 			ThenFunc(s.handleGetVersion))
+	s.mux.Handle("GET /api/health/cloud-accounts",
+		s.loggerChain().
+			Append(s.authHandler).
+			ThenFunc(s.handleCloudAccountHealth))
 }
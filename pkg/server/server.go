@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"iv/pkg/config"
 	"iv/pkg/logging"
 	"iv/pkg/server/driver"
 	"net/http"
@@ -20,6 +21,8 @@ type Server struct {
 	Driver driver.Server
 	Logger zerolog.Logger // to be passed as generics?
 	Addr   string
+	// Health is the cloud account monitor, nil when not configured
+	Health *HealthMonitor
 	Services
 }
 
@@ -78,6 +81,18 @@ func RunServer() error {
 	// requests to its handler
 	s := New(http.NewServeMux(), NewDriver(), lgr)
 	s.Addr = ":8081"
+	ctx, stop := context.WithCancel(context.Background())
+	defer stop()
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	if cfg.Monitor != nil {
+		if s.Health, err = NewHealthMonitor(cfg, lgr); err != nil {
+			return fmt.Errorf("monitor: %w", err)
+		}
+		go s.Health.Run(ctx)
+	}
 	errCh := make(chan error, 1)
 	fmt.Println("Starting to serve... ")
 	go func() {
@@ -93,6 +108,7 @@ func RunServer() error {
 		lgr.Info().Msgf("server start error: %v", err)
 	case <-sigInt:
 		lgr.Info().Msgf("shutdown signal received")
+		stop()
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 		if err := s.Shutdown(ctx); err != nil {
@@ -0,0 +1,148 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// package snapshots applies a retention policy to machine snapshots
+
+// Policy says which snapshots may be deleted, the current snapshot of a
+// machine is always kept
+type Policy struct {
+	// KeepLast keeps the newest N snapshots of every machine
+	KeepLast int
+	// OlderThan only deletes snapshots older than this, 0 means any age
+	OlderThan time.Duration
+}
+
+// Validate refuses a policy that keeps nothing, or has a negative count or
+// age that would otherwise select every snapshot
+func (p Policy) Validate() error {
+	switch {
+	case p.KeepLast < 0:
+		return fmt.Errorf("keep-last %d is negative", p.KeepLast)
+	case p.OlderThan < 0:
+		return fmt.Errorf("older-than %s is negative", p.OlderThan)
+	case p.KeepLast == 0 && p.OlderThan == 0:
+		return errors.New("set keep-last and/or older-than")
+	}
+	return nil
+}
+
+// Select returns the snapshots of one machine the policy deletes, oldest
+// first; an invalid policy selects nothing
+func (p Policy) Select(snaps []iaas.Snapshot, now time.Time) []iaas.Snapshot {
+	if p.Validate() != nil {
+		return nil
+	}
+	sorted := append([]iaas.Snapshot(nil), snaps...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt > sorted[j].CreatedAt })
+	var out []iaas.Snapshot
+	for i, s := range sorted {
+		if i < p.KeepLast || s.IsCurrent {
+			continue
+		}
+		if p.OlderThan > 0 {
+			created, err := time.Parse(time.RFC3339, s.CreatedAt)
+			// keep what cannot be dated
+			if err != nil || now.Sub(created) < p.OlderThan {
+				continue
+			}
+		}
+		out = append(out, s)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].CreatedAt < out[j].CreatedAt })
+	return out
+}
+
+const (
+	StatusDeleted     = "deleted"
+	StatusWouldDelete = "would-delete"
+	StatusFailed      = "failed"
+)
+
+// Result is the outcome for one snapshot
+type Result struct {
+	MachineID  string `json:"machineId"`
+	Machine    string `json:"machine"`
+	SnapshotID string `json:"snapshotId"`
+	Snapshot   string `json:"snapshot"`
+	CreatedAt  string `json:"createdAt"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Pruner deletes snapshots across machines, machines are handled in parallel
+// and the snapshots of one machine one after the other
+type Pruner struct {
+	Client      *iaas.Client
+	Policy      Policy
+	Concurrency int
+	DryRun      bool
+	// OnResult is called for every snapshot, from one goroutine at a time
+	OnResult func(Result)
+}
+
+// Run prunes the snapshots of the given machines and returns the failures
+func (p *Pruner) Run(ctx context.Context, machines []iaas.Machine) error {
+	if err := p.Policy.Validate(); err != nil {
+		return err
+	}
+	n := p.Concurrency
+	if n < 1 {
+		n = 1
+	}
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	report := func(r Result, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			r.Status, r.Error = StatusFailed, err.Error()
+			errs = append(errs, fmt.Errorf("%s %s: %w", r.Machine, r.SnapshotID, err))
+		}
+		if p.OnResult != nil {
+			p.OnResult(r)
+		}
+	}
+	sem := make(chan struct{}, n)
+	now := time.Now()
+	for _, m := range machines {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(m iaas.Machine) {
+			defer func() { <-sem; wg.Done() }()
+			snaps, err := p.Client.ListMachineSnapshots(ctx, m.ID)
+			if err != nil {
+				report(Result{MachineID: m.ID, Machine: m.Name}, err)
+				return
+			}
+			for _, s := range p.Policy.Select(snaps, now) {
+				r := Result{MachineID: m.ID, Machine: m.Name, SnapshotID: s.ID, Snapshot: s.Name, CreatedAt: s.CreatedAt}
+				if p.DryRun {
+					r.Status = StatusWouldDelete
+					report(r, nil)
+					continue
+				}
+				t, err := p.Client.DeleteMachineSnapshot(ctx, m.ID, s.ID)
+				if err == nil {
+					_, err = p.Client.Wait(ctx, t)
+				}
+				r.Status = StatusDeleted
+				report(r, err)
+			}
+		}(m)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,69 @@
+package snapshots
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+func snap(id, createdAt string, current bool) iaas.Snapshot {
+	s := iaas.Snapshot{IsCurrent: current}
+	s.ID, s.CreatedAt = id, createdAt
+	return s
+}
+
+func TestSelect(t *testing.T) {
+	now := time.Date(2024, time.June, 30, 12, 0, 0, 0, time.UTC)
+	snaps := []iaas.Snapshot{
+		snap("jan", "2024-01-01T00:00:00Z", false),
+		snap("jun1", "2024-06-01T00:00:00Z", false),
+		snap("mar", "2024-03-01T00:00:00Z", true),
+		snap("jun29", "2024-06-29T00:00:00Z", false),
+		snap("feb", "2024-02-01T00:00:00Z", false),
+		snap("undated", "", false),
+	}
+	for _, tt := range []struct {
+		policy Policy
+		want   string
+	}{
+		// newest first: jun29 jun1 mar(current) feb jan, undated sorts last
+		{Policy{KeepLast: 1}, "undated jan feb jun1"},
+		{Policy{KeepLast: 3}, "undated jan feb"},
+		{Policy{KeepLast: 10}, ""},
+		// undated snapshots are kept once an age is asked for
+		{Policy{OlderThan: 7 * 24 * time.Hour}, "jan feb jun1"},
+		{Policy{KeepLast: 4, OlderThan: 24 * time.Hour}, "jan"},
+		{Policy{OlderThan: 365 * 24 * time.Hour}, ""},
+		// invalid policies select nothing rather than everything
+		{Policy{}, ""},
+		{Policy{KeepLast: -1}, ""},
+		{Policy{OlderThan: -time.Hour}, ""},
+		{Policy{KeepLast: 1, OlderThan: -time.Hour}, ""},
+	} {
+		var ids []string
+		for _, s := range tt.policy.Select(snaps, now) {
+			ids = append(ids, s.ID)
+		}
+		if got := strings.Join(ids, " "); got != tt.want {
+			t.Errorf("%+v: Select() = %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for p, want := range map[Policy]string{
+		{KeepLast: 1}:                   "",
+		{OlderThan: time.Hour}:          "",
+		{}:                              "set keep-last",
+		{KeepLast: -2}:                  "negative",
+		{KeepLast: 2, OlderThan: -1}:    "negative",
+		{KeepLast: 0, OlderThan: -3600}: "negative",
+	} {
+		err := p.Validate()
+		if (err == nil) != (want == "") || err != nil && !strings.Contains(err.Error(), want) {
+			t.Errorf("%+v: Validate() = %v, want %q", p, err, want)
+		}
+	}
+}
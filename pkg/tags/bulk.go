@@ -0,0 +1,118 @@
+package tags
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"iv/pkg/endpoints/vra/iaas"
+)
+
+// package tags changes the tags of many IaaS resources at once
+
+// result states
+const (
+	StatusUpdated     = "updated"
+	StatusWouldUpdate = "would-update"
+	StatusUnchanged   = "unchanged"
+	StatusFailed      = "failed"
+)
+
+// Result is what happened to one resource
+type Result struct {
+	ID     string     `json:"id"`
+	Name   string     `json:"name"`
+	Status string     `json:"status"`
+	Before []iaas.Tag `json:"before"`
+	After  []iaas.Tag `json:"after"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// Bulk patches the tags of the resources of one collection in parallel, tags
+// that are not set or removed stay as they are
+type Bulk struct {
+	Client *iaas.Client
+	// Collection is one of iaas.TagCollections
+	Collection  string
+	Set         []iaas.Tag
+	Remove      []string
+	Concurrency int
+	DryRun      bool
+	// OnResult is called for every resource, from one goroutine at a time
+	OnResult func(Result)
+}
+
+// Run updates the given resources and returns the failures
+func (b *Bulk) Run(ctx context.Context, objs []iaas.Object) error {
+	n := b.Concurrency
+	if n < 1 {
+		n = 1
+	}
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	report := func(r Result, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			r.Status, r.Error = StatusFailed, err.Error()
+			errs = append(errs, fmt.Errorf("%s: %w", r.Name, err))
+		}
+		if b.OnResult != nil {
+			b.OnResult(r)
+		}
+	}
+	sem := make(chan struct{}, n)
+	for _, o := range objs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(o iaas.Object) {
+			defer func() { <-sem; wg.Done() }()
+			r := Result{ID: str(o["id"]), Name: str(o["name"])}
+			current, err := objectTags(o)
+			if err != nil {
+				report(r, err)
+				return
+			}
+			after, changed := iaas.MergeTags(current, b.Set, b.Remove)
+			r.Before, r.After = current, after
+			switch {
+			case !changed:
+				r.Status = StatusUnchanged
+			case b.DryRun:
+				r.Status = StatusWouldUpdate
+			default:
+				// PATCH replaces the whole tag set
+				_, err = b.Client.UpdateObject(ctx, http.MethodPatch, b.Collection, r.ID, map[string]any{"tags": after})
+				r.Status = StatusUpdated
+			}
+			report(r, err)
+		}(o)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// objectTags reads the tags of an untyped resource
+func objectTags(o iaas.Object) ([]iaas.Tag, error) {
+	var tags []iaas.Tag
+	if o["tags"] == nil {
+		return tags, nil
+	}
+	data, err := json.Marshal(o["tags"])
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &tags)
+	return tags, err
+}
+
+func str(v any) string {
+	s, _ := v.(string)
+	return s
+}
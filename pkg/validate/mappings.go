@@ -0,0 +1,319 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"iv/pkg/endpoints/vra/iaas"
+	"iv/pkg/rest"
+)
+
+// package validate checks vRA configuration for problems that otherwise
+// only show when a deployment fails
+
+const (
+	KindFlavor = "flavor"
+	KindImage  = "image"
+
+	ProblemNotMapped = "not mapped"
+)
+
+// Gap is a logical flavor or image that cannot be used in a region
+type Gap struct {
+	Kind     string   `json:"kind"`
+	Name     string   `json:"name"`
+	Region   string   `json:"region"`
+	Profile  string   `json:"profile,omitempty"`
+	Problem  string   `json:"problem"`
+	Projects []string `json:"projects,omitempty"`
+}
+
+// GapHeader names the columns of Gap.Strings
+var GapHeader = []string{"kind", "name", "region", "profile", "problem", "projects"}
+
+func (g Gap) Strings() []string {
+	return []string{g.Kind, g.Name, g.Region, g.Profile, g.Problem, strings.Join(g.Projects, ",")}
+}
+
+// region identifies a region by cloud account and external id, an empty
+// account matches any
+type region struct {
+	account  string
+	external string
+}
+
+func (r region) matches(o region) bool {
+	return r.external == o.external && (r.account == "" || o.account == "" || r.account == o.account)
+}
+
+// usedRegion is a region some project places machines in
+type usedRegion struct {
+	region
+	label    string
+	projects []string
+}
+
+// Mappings checks that every logical flavor and image is mapped in every
+// region the zones of the projects matching filter use, and that the fabric
+// flavors and images the mappings name still exist
+func Mappings(ctx context.Context, c *iaas.Client, projectFilter string) ([]Gap, error) {
+	used, err := usedRegions(ctx, c, projectFilter)
+	if err != nil {
+		return nil, err
+	}
+	flavors, err := c.ListFlavorProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list flavor profiles: %w", err)
+	}
+	images, err := c.ListImageProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list image profiles: %w", err)
+	}
+
+	var gaps []Gap
+	// logical names are the keys any profile defines
+	flavorNames, imageNames := map[string]bool{}, map[string]bool{}
+	for _, p := range flavors {
+		for name := range p.FlavorMappings.Mapping {
+			flavorNames[name] = true
+		}
+	}
+	for _, p := range images {
+		for name := range p.ImageMappings.Mapping {
+			imageNames[name] = true
+		}
+	}
+	for _, u := range used {
+		for _, name := range sorted(flavorNames) {
+			if !flavorMapped(flavors, u.region, name) {
+				gaps = append(gaps, Gap{Kind: KindFlavor, Name: name, Region: u.label, Problem: ProblemNotMapped, Projects: u.projects})
+			}
+		}
+		for _, name := range sorted(imageNames) {
+			if !imageMapped(images, u.region, name) {
+				gaps = append(gaps, Gap{Kind: KindImage, Name: name, Region: u.label, Problem: ProblemNotMapped, Projects: u.projects})
+			}
+		}
+	}
+
+	stale, err := staleFlavors(ctx, c, flavors, used)
+	if err != nil {
+		return nil, err
+	}
+	gaps = append(gaps, stale...)
+	if stale, err = staleImages(ctx, c, images, used); err != nil {
+		return nil, err
+	}
+	gaps = append(gaps, stale...)
+	sort.SliceStable(gaps, func(i, j int) bool {
+		a, b := gaps[i], gaps[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		if a.Region != b.Region {
+			return a.Region < b.Region
+		}
+		return a.Name < b.Name
+	})
+	return gaps, nil
+}
+
+// usedRegions are the regions of the zones of the projects, with the names
+// of the projects using them
+func usedRegions(ctx context.Context, c *iaas.Client, projectFilter string) ([]*usedRegion, error) {
+	projects, err := c.ListProjects(ctx, &iaas.ListOptions{Filter: projectFilter})
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	zones, err := c.ListZones(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list zones: %w", err)
+	}
+	regions, err := c.ListRegions(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("list regions: %w", err)
+	}
+	zoneByID := map[string]iaas.Zone{}
+	for _, z := range zones {
+		zoneByID[z.ID] = z
+	}
+	var used []*usedRegion
+	for _, p := range projects {
+		for _, za := range p.Zones {
+			z, ok := zoneByID[za.ZoneID]
+			if !ok {
+				continue
+			}
+			r := region{account: z.CloudAccountID, external: z.ExternalRegionID}
+			var u *usedRegion
+			for _, have := range used {
+				if have.region == r {
+					u = have
+				}
+			}
+			if u == nil {
+				u = &usedRegion{region: r, label: r.external}
+				for _, reg := range regions {
+					if r.matches(region{account: reg.CloudAccountID, external: reg.ExternalRegionID}) && reg.Name != "" {
+						u.label = reg.Name + " (" + r.external + ")"
+					}
+				}
+				used = append(used, u)
+			}
+			if len(u.projects) == 0 || u.projects[len(u.projects)-1] != p.Name {
+				u.projects = append(u.projects, p.Name)
+			}
+		}
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i].label < used[j].label })
+	return used, nil
+}
+
+func flavorMapped(profiles []iaas.FlavorProfile, r region, name string) bool {
+	for _, p := range profiles {
+		if _, ok := p.FlavorMappings.Mapping[name]; ok && r.matches(profileRegion(p.CloudAccountID, p.ExternalRegionID, p.FlavorMappings.ExternalRegionID)) {
+			return true
+		}
+	}
+	return false
+}
+
+func imageMapped(profiles []iaas.ImageProfile, r region, name string) bool {
+	for _, p := range profiles {
+		if _, ok := p.ImageMappings.Mapping[name]; ok && r.matches(profileRegion(p.CloudAccountID, p.ExternalRegionID, p.ImageMappings.ExternalRegionID)) {
+			return true
+		}
+	}
+	return false
+}
+
+// profileRegion is the region of a profile, older releases only set it on
+// the mapping
+func profileRegion(account, external, mappingExternal string) region {
+	if external == "" {
+		external = mappingExternal
+	}
+	return region{account: account, external: external}
+}
+
+// label and projects of the region of a profile, for profiles of regions no
+// project uses the external id alone
+func describe(used []*usedRegion, r region) (string, []string) {
+	for _, u := range used {
+		if u.matches(r) {
+			return u.label, u.projects
+		}
+	}
+	return r.external, nil
+}
+
+// staleFlavors reports mappings to fabric flavors that no longer exist;
+// vSphere flavors are a size without id or name and are not checked. The
+// fabric flavors are one list across regions in the API, a flavor that only
+// exists in another region is not caught
+func staleFlavors(ctx context.Context, c *iaas.Client, profiles []iaas.FlavorProfile, used []*usedRegion) ([]Gap, error) {
+	fabric, err := c.ListFabricFlavors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list fabric flavors: %w", err)
+	}
+	known := map[string]bool{}
+	for _, f := range fabric {
+		known["id:"+f.ID], known["name:"+f.Name] = true, true
+	}
+	var gaps []Gap
+	for _, p := range profiles {
+		label, projects := describe(used, profileRegion(p.CloudAccountID, p.ExternalRegionID, p.FlavorMappings.ExternalRegionID))
+		for _, name := range sorted(keys(p.FlavorMappings.Mapping)) {
+			f := p.FlavorMappings.Mapping[name]
+			ref := f.Name
+			if f.ID != "" {
+				ref = f.ID
+			}
+			if ref == "" || (f.ID != "" && known["id:"+f.ID]) || (f.Name != "" && known["name:"+f.Name]) {
+				continue
+			}
+			gaps = append(gaps, Gap{Kind: KindFlavor, Name: name, Region: label, Profile: p.Name,
+				Problem: fmt.Sprintf("fabric flavor %s not found", ref), Projects: projects})
+		}
+	}
+	return gaps, nil
+}
+
+// staleImages reports mappings to fabric images that no longer exist, images
+// are looked up one by one since a region can have thousands
+func staleImages(ctx context.Context, c *iaas.Client, profiles []iaas.ImageProfile, used []*usedRegion) ([]Gap, error) {
+	found := map[string]bool{}
+	exists := func(img iaas.ImageMappingDescription, region string) (string, bool, error) {
+		var ref, filter string
+		switch {
+		case img.ID != "":
+			ref = img.ID
+		case img.ExternalID != "":
+			ref, filter = img.ExternalID, "externalId eq "+iaas.Quote(img.ExternalID)
+		case img.Name != "":
+			ref, filter = img.Name, "name eq "+iaas.Quote(img.Name)
+		default:
+			return "", true, nil
+		}
+		if region != "" && filter != "" {
+			filter += " and externalRegionId eq " + iaas.Quote(region)
+		}
+		key := ref + "\x00" + filter
+		if ok, done := found[key]; done {
+			return ref, ok, nil
+		}
+		var ok bool
+		if filter == "" {
+			_, err := c.GetFabricImage(ctx, img.ID)
+			if code := rest.StatusCode(err); err != nil && code != http.StatusNotFound {
+				return ref, false, err
+			}
+			ok = err == nil
+		} else {
+			all, err := c.ListFabricImages(ctx, &iaas.ListOptions{Filter: filter, Limit: 1})
+			if err != nil {
+				return ref, false, err
+			}
+			ok = len(all) > 0
+		}
+		found[key] = ok
+		return ref, ok, nil
+	}
+
+	var gaps []Gap
+	for _, p := range profiles {
+		r := profileRegion(p.CloudAccountID, p.ExternalRegionID, p.ImageMappings.ExternalRegionID)
+		label, projects := describe(used, r)
+		for _, name := range sorted(keys(p.ImageMappings.Mapping)) {
+			ref, ok, err := exists(p.ImageMappings.Mapping[name], r.external)
+			if err != nil {
+				return nil, fmt.Errorf("image %s of %s: %w", name, p.Name, err)
+			}
+			if !ok {
+				gaps = append(gaps, Gap{Kind: KindImage, Name: name, Region: label, Profile: p.Name,
+					Problem: fmt.Sprintf("fabric image %s not found", ref), Projects: projects})
+			}
+		}
+	}
+	return gaps, nil
+}
+
+func keys[V any](m map[string]V) map[string]bool {
+	out := make(map[string]bool, len(m))
+	for k := range m {
+		out[k] = true
+	}
+	return out
+}
+
+func sorted(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for k := range set {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -834,13 +834,13 @@ type RolesWithGroupInfo struct {
 	ServiceRoles *[]ServiceRolesWithGroupsCollection `json:"serviceRoles,omitempty"`
 }
 
-// SearchGroupsResponse defines model for SearchGroupsResponse.
-type SearchGroupsResponse struct {
+// SearchGroupsResult defines model for SearchGroupsResponse.
+type SearchGroupsResult struct {
 	Results []ExpandedGroup `json:"results"`
 }
 
-// SearchUsersResponse defines model for SearchUsersResponse.
-type SearchUsersResponse struct {
+// SearchUsersResult defines model for SearchUsersResponse.
+type SearchUsersResult struct {
 	// Results List of users that are found.
 	Results *[]ExpandedTypedUser `json:"results,omitempty"`
 }